@@ -0,0 +1,139 @@
+// Package invalidation creates CloudFront invalidations and waits for them to complete.
+package invalidation
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+)
+
+// EndpointVariable is the environment variable that overrides the CloudFront endpoint, e.g. with a
+// local stand-in of the CloudFront API. The AWS SDKs read the same variable.
+const EndpointVariable = "AWS_ENDPOINT_URL_CLOUDFRONT"
+
+// maxWait is the time to wait for an invalidation to complete.
+const maxWait = 30 * time.Minute
+
+// Client is the part of the CloudFront API an Invalidator uses.
+type Client interface {
+	cloudfront.GetInvalidationAPIClient
+	CreateInvalidation(ctx context.Context, params *cloudfront.CreateInvalidationInput,
+		optFns ...func(*cloudfront.Options)) (*cloudfront.CreateInvalidationOutput, error)
+}
+
+// Config holds the settings of the AWS provider of the stack. Settings that are not given are
+// taken from the environment and the shared AWS config, like the AWS provider does.
+type Config struct {
+	// The profile of the shared AWS config.
+	Profile string
+	// Static credentials, used instead of the credential chain if AccessKey is set.
+	AccessKey string
+	SecretKey string
+	Token     string
+	// The ARN of a role to assume with the credentials.
+	RoleArn     string
+	SessionName string
+	ExternalId  string
+	// The CloudFront endpoint. Defaults to the value of EndpointVariable.
+	Endpoint string
+}
+
+// Invalidator invalidates the paths of CloudFront distributions.
+type Invalidator struct {
+	client Client
+	// waitOptions adjust the waiter of the invalidation, e.g. its polling delays.
+	waitOptions []func(*cloudfront.InvalidationCompletedWaiterOptions)
+}
+
+// New creates an Invalidator that uses the given CloudFront client.
+func New(client Client) *Invalidator {
+	return &Invalidator{client: client}
+}
+
+// NewFromConfig creates an Invalidator with the credentials resolved from the config.
+func NewFromConfig(ctx context.Context, c Config) (*Invalidator, error) {
+	// CloudFront is a global service, whose API is signed for us-east-1.
+	options := []func(*config.LoadOptions) error{config.WithRegion("us-east-1")}
+	if c.Profile != "" {
+		options = append(options, config.WithSharedConfigProfile(c.Profile))
+	}
+	if c.AccessKey != "" {
+		options = append(options, config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(c.AccessKey, c.SecretKey, c.Token)))
+	}
+	awsConfig, err := config.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading AWS config")
+	}
+	if c.RoleArn != "" {
+		awsConfig.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(awsConfig), c.RoleArn,
+			func(o *stscreds.AssumeRoleOptions) {
+				if c.SessionName != "" {
+					o.RoleSessionName = c.SessionName
+				}
+				if c.ExternalId != "" {
+					o.ExternalID = aws.String(c.ExternalId)
+				}
+			}))
+	}
+
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = os.Getenv(EndpointVariable)
+	}
+	return New(cloudfront.NewFromConfig(awsConfig, func(o *cloudfront.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})), nil
+}
+
+// Invalidate invalidates the paths of the distribution and returns the ID of the invalidation once
+// it has completed. Paths start with / and may end with a * wildcard.
+func (i *Invalidator) Invalidate(ctx context.Context, distributionId string, paths []string) (string, error) {
+	if len(paths) == 0 {
+		return "", errors.New("no paths to invalidate")
+	}
+	for _, path := range paths {
+		if !strings.HasPrefix(path, "/") {
+			return "", errors.Errorf("invalidation paths must start with /, got %q", path)
+		}
+	}
+
+	output, err := i.client.CreateInvalidation(ctx, &cloudfront.CreateInvalidationInput{
+		DistributionId: aws.String(distributionId),
+		InvalidationBatch: &types.InvalidationBatch{
+			// The caller reference identifies the request, so that retries do not create another
+			// invalidation.
+			CallerReference: aws.String("gotiac-" + strconv.FormatInt(time.Now().UnixNano(), 10)),
+			Paths: &types.Paths{
+				Items:    paths,
+				Quantity: aws.Int32(int32(len(paths))),
+			},
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "creating invalidation of distribution %s", distributionId)
+	}
+	invalidationId := aws.ToString(output.Invalidation.Id)
+
+	waiter := cloudfront.NewInvalidationCompletedWaiter(i.client, i.waitOptions...)
+	if err := waiter.Wait(ctx, &cloudfront.GetInvalidationInput{
+		DistributionId: aws.String(distributionId),
+		Id:             aws.String(invalidationId),
+	}, maxWait); err != nil {
+		return "", errors.Wrapf(err, "waiting for invalidation %s of distribution %s", invalidationId, distributionId)
+	}
+	return invalidationId, nil
+}
@@ -2,11 +2,14 @@ package provider
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws"
 	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/acm"
 	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/cloudfront"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/kms"
 	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/route53"
 	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/s3"
 	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/ssm"
@@ -17,20 +20,317 @@ import (
 // The set of arguments for creating a FileHosting component resource.
 type FileHostingArgs struct {
 	// The file hosting domain.
-	Domain pulumi.StringInput `pulumi:"domain"`
+	Domain string `pulumi:"domain"`
+	// Additional domains the files are served under. They are added to the certificate as
+	// subject alternative names.
+	Aliases []string `pulumi:"aliases"`
 	// The name of existing s3 Bucket to link as origin. If not provided, a new bucket
 	// will be created.
 	BucketName *pulumi.StringInput `pulumi:"bucketName"`
+	// The ARN of the KMS key the existing bucket is encrypted with, if it uses SSE-KMS. It is added
+	// to the bucket reader and writer policies. The key policy has to allow the distribution and the
+	// roles to use the key. Requires bucketName.
+	BucketKmsKeyArn *pulumi.StringInput `pulumi:"bucketKmsKeyArn"`
+	// The ARN of an existing ACM certificate in us-east-1 covering the domain. If not provided, a
+	// new certificate will be issued and validated.
+	CertificateArn *pulumi.StringInput `pulumi:"certificateArn"`
+	// The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by
+	// the domain and its parent domains.
+	HostedZoneId *pulumi.StringInput `pulumi:"hostedZoneId"`
+	// Whether to create the DNS records in Route 53. If false, no records are created and the
+	// records to create in an external DNS provider are returned as outputs. Defaults to true.
+	ManageDns *bool `pulumi:"manageDns"`
+	// Whether the distribution is reachable over IPv6. If true, AAAA records are created next to
+	// the A records. Defaults to true.
+	Ipv6Enabled *bool `pulumi:"ipv6Enabled"`
+	// Whether the validation records of a new certificate have been created in the external DNS
+	// provider. Without managed DNS and certificateArn, deploying takes two updates: the first only
+	// creates the certificate and returns its validation records, the second, with this set, waits
+	// for the validation and creates the distribution. Defaults to false.
+	CertificateValidated *bool `pulumi:"certificateValidated"`
+	// The rotation of the keys trusted to sign URLs and cookies. If not provided, a single key is
+	// used.
+	KeyRotation *FileHostingKeyRotationArgs `pulumi:"keyRotation"`
+	// Additional cache behaviors for path patterns, in order of precedence. Paths that match none
+	// of the patterns use the default behavior, which requires signed URLs or cookies.
+	CacheBehaviors []FileHostingCacheBehaviorArgs `pulumi:"cacheBehaviors"`
+	// Which writes CloudFront passes to the bucket: full allows uploads through the default
+	// behavior, signed-upload only through the upload path pattern with its own key group and
+	// read-only none at all. Defaults to full.
+	UploadMode *string `pulumi:"uploadMode"`
+	// The path pattern uploads are accepted under in signed-upload mode. Defaults to uploads/*.
+	UploadPathPattern *string `pulumi:"uploadPathPattern"`
+	// The settings of the cache policy to create. Conflicts with cachePolicyId.
+	CachePolicy *FileHostingCachePolicyArgs `pulumi:"cachePolicy"`
+	// The ID of an existing cache policy to use instead of creating one.
+	CachePolicyId *pulumi.StringInput `pulumi:"cachePolicyId"`
+	// The settings of the origin request policy to create. Conflicts with originRequestPolicyId.
+	OriginRequestPolicy *FileHostingOriginRequestPolicyArgs `pulumi:"originRequestPolicy"`
+	// The ID of an existing origin request policy to use instead of creating one.
+	OriginRequestPolicyId *pulumi.StringInput `pulumi:"originRequestPolicyId"`
+	// The CORS settings of the response headers policy. If not provided, all origins are allowed.
+	Cors *FileHostingCorsArgs `pulumi:"cors"`
+	// The security headers of the response headers policy.
+	SecurityHeaders *FileHostingSecurityHeadersArgs `pulumi:"securityHeaders"`
+	// The access logging settings. If not provided, no access logs are written.
+	Logging *FileHostingLoggingArgs `pulumi:"logging"`
+	// The ARN of an existing WAF web ACL to attach to the distribution. Conflicts with webAcl.
+	WebAclArn *pulumi.StringInput `pulumi:"webAclArn"`
+	// The settings of a WAF web ACL to create in us-east-1 and attach to the distribution.
+	// Conflicts with webAclArn.
+	WebAcl *WebAclArgs `pulumi:"webAcl"`
+	// The countries requests are allowed from or blocked from. If not provided, requests from all
+	// countries are served.
+	GeoRestriction *FileHostingGeoRestrictionArgs `pulumi:"geoRestriction"`
+	// The price class of the distribution: PriceClass_All, PriceClass_200 or PriceClass_100.
+	// Defaults to PriceClass_All.
+	PriceClass *string `pulumi:"priceClass"`
+	// The settings of the bucket that is created when no bucket name is given. Conflicts with
+	// bucketName.
+	BucketOptions *FileHostingBucketArgs `pulumi:"bucketOptions"`
+	// How the bucket policy is written: replace overwrites the whole policy, merge keeps the
+	// statements of other systems and only adds or updates the statements of the component.
+	// Merge requires bucketName. Defaults to replace.
+	BucketPolicyMode *string `pulumi:"bucketPolicyMode"`
+	// Whether the ownership controls of the bucket are managed by the component. Defaults to true.
+	ManageOwnershipControls *bool `pulumi:"manageOwnershipControls"`
+	// Whether the public access block of the bucket is managed by the component. Defaults to true.
+	ManagePublicAccessBlock *bool `pulumi:"managePublicAccessBlock"`
+	// The region of the bucket. The bucket and its policy are managed with a provider for this
+	// region. Defaults to the region of the stack.
+	BucketRegion *pulumi.StringInput `pulumi:"bucketRegion"`
+	// The region of the Origin Shield requests to the buckets go through. If not provided, Origin
+	// Shield is disabled.
+	OriginShieldRegion *pulumi.StringInput `pulumi:"originShieldRegion"`
+	// The bucket CloudFront fails over to when the bucket returns an error. If not provided,
+	// requests are only served from the bucket.
+	Failover *FileHostingFailoverArgs `pulumi:"failover"`
+	// The CloudFront Functions and Lambda@Edge functions of the default cache behavior. They also
+	// run on the upload behavior of the signed-upload mode.
+	EdgeHandlers *FileHostingEdgeHandlersArgs `pulumi:"edgeHandlers"`
+	// The event notifications of the bucket, e.g. to post-process uploaded files. If not provided,
+	// no notifications are configured.
+	Notifications *FileHostingNotificationsArgs `pulumi:"notifications"`
+	// Whether managed IAM policies are created for the signer, bucket reader and bucket writer
+	// roles. The policy documents are returned either way. Defaults to false.
+	ManagedPolicies *bool `pulumi:"managedPolicies"`
+}
+
+// The geo restriction of a FileHosting component resource.
+type FileHostingGeoRestrictionArgs struct {
+	// How the locations are applied: whitelist only allows requests from them, blacklist blocks
+	// them and none disables the restriction.
+	RestrictionType string `pulumi:"restrictionType"`
+	// The ISO 3166-1 alpha-2 codes of the countries the restriction type applies to.
+	Locations []string `pulumi:"locations"`
+}
+
+// The price classes of CloudFront distributions.
+var priceClasses = []string{"PriceClass_All", "PriceClass_200", "PriceClass_100"}
+
+// The upload modes of a FileHosting component resource.
+const (
+	uploadModeFull         = "full"
+	uploadModeReadOnly     = "read-only"
+	uploadModeSignedUpload = "signed-upload"
+)
+
+// A cache behavior of a FileHosting component resource for a path pattern.
+type FileHostingCacheBehaviorArgs struct {
+	// The path pattern the behavior applies to, e.g. public/*.
+	PathPattern pulumi.StringInput `pulumi:"pathPattern"`
+	// Whether requests require signed URLs or cookies. Defaults to true.
+	Signed *bool `pulumi:"signed"`
+	// The HTTP methods CloudFront processes and forwards to the bucket. Defaults to GET, HEAD and
+	// OPTIONS. With failover, a behavior allowing other methods targets the bucket directly.
+	AllowedMethods *pulumi.StringArrayInput `pulumi:"allowedMethods"`
+	// The ID of the cache policy of the behavior. Defaults to the cache policy of the component.
+	CachePolicyId *pulumi.StringInput `pulumi:"cachePolicyId"`
+	// The CloudFront Functions and Lambda@Edge functions of the behavior.
+	EdgeHandlers *FileHostingEdgeHandlersArgs `pulumi:"edgeHandlers"`
+}
+
+// uploadMode returns the validated upload mode of the FileHosting.
+func (args *FileHostingArgs) uploadMode() (string, error) {
+	if args.UploadMode == nil {
+		return uploadModeFull, nil
+	}
+	switch *args.UploadMode {
+	case uploadModeFull, uploadModeReadOnly, uploadModeSignedUpload:
+		return *args.UploadMode, nil
+	default:
+		return "", fmt.Errorf("uploadMode must be one of %s, %s or %s, got %q",
+			uploadModeFull, uploadModeReadOnly, uploadModeSignedUpload, *args.UploadMode)
+	}
+}
+
+// The maximum number of public keys CloudFront accepts in a key group.
+const maxKeyGroupKeys = 5
+
+// The key rotation settings of a FileHosting component resource.
+type FileHostingKeyRotationArgs struct {
+	// The generation of the key used for signing. Incrementing it creates a new key and rotates
+	// signing to it. Defaults to 1.
+	ActiveGeneration *int `pulumi:"activeGeneration"`
+	// The number of key generations kept in the trusted key group, including the active one.
+	// Previous keys stay trusted so that outstanding signatures remain valid. Defaults to 2.
+	Generations *int `pulumi:"generations"`
+}
+
+// validateFileHostingArgs checks the arguments of a FileHosting for values that would only be
+// rejected once the resources are created.
+func validateFileHostingArgs(args *FileHostingArgs) error {
+	if _, _, err := args.KeyRotation.keyGenerations(); err != nil {
+		return err
+	}
+	if _, err := args.uploadMode(); err != nil {
+		return err
+	}
+	if args.CachePolicy != nil && args.CachePolicyId != nil {
+		return errors.New("only one of cachePolicy and cachePolicyId can be set")
+	}
+	if args.OriginRequestPolicy != nil && args.OriginRequestPolicyId != nil {
+		return errors.New("only one of originRequestPolicy and originRequestPolicyId can be set")
+	}
+	if args.WebAcl != nil && args.WebAclArn != nil {
+		return errors.New("only one of webAcl and webAclArn can be set")
+	}
+	if args.BucketPolicyMode != nil {
+		switch *args.BucketPolicyMode {
+		case bucketPolicyModeReplace:
+		case bucketPolicyModeMerge:
+			if args.BucketName == nil {
+				return fmt.Errorf("bucketPolicyMode %s requires bucketName", bucketPolicyModeMerge)
+			}
+		default:
+			return fmt.Errorf("bucketPolicyMode must be one of %s or %s, got %q",
+				bucketPolicyModeReplace, bucketPolicyModeMerge, *args.BucketPolicyMode)
+		}
+	}
+	if args.BucketKmsKeyArn != nil && args.BucketName == nil {
+		return errors.New("bucketKmsKeyArn requires bucketName")
+	}
+	if args.BucketOptions != nil {
+		if args.BucketName != nil {
+			return errors.New("only one of bucketOptions and bucketName can be set")
+		}
+		if err := args.BucketOptions.validate(); err != nil {
+			return err
+		}
+	}
+	if args.PriceClass != nil && !slices.Contains(priceClasses, *args.PriceClass) {
+		return fmt.Errorf("priceClass must be one of %s, got %q", strings.Join(priceClasses, ", "), *args.PriceClass)
+	}
+	if geo := args.GeoRestriction; geo != nil {
+		switch geo.RestrictionType {
+		case "none":
+			if len(geo.Locations) > 0 {
+				return errors.New("geoRestriction.locations must be empty when the restriction type is none")
+			}
+		case "whitelist", "blacklist":
+			if len(geo.Locations) == 0 {
+				return fmt.Errorf("geoRestriction.locations must not be empty when the restriction type is %s", geo.RestrictionType)
+			}
+		default:
+			return fmt.Errorf("geoRestriction.restrictionType must be one of none, whitelist or blacklist, got %q", geo.RestrictionType)
+		}
+		for _, location := range geo.Locations {
+			if !isCountryCode(location) {
+				return fmt.Errorf("geoRestriction.locations must be ISO 3166-1 alpha-2 country codes, got %q", location)
+			}
+		}
+	}
+	if args.Failover != nil {
+		if err := args.Failover.validate(args); err != nil {
+			return err
+		}
+	}
+	if args.Cors != nil {
+		if err := args.Cors.validate(); err != nil {
+			return err
+		}
+	}
+	if args.Notifications != nil {
+		if err := args.Notifications.validate(); err != nil {
+			return err
+		}
+	}
+	if err := args.EdgeHandlers.validate("edgeHandlers"); err != nil {
+		return err
+	}
+	for i, behavior := range args.CacheBehaviors {
+		if err := behavior.EdgeHandlers.validate(fmt.Sprintf("cacheBehaviors[%d].edgeHandlers", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isCountryCode reports whether the value has the format of an ISO 3166-1 alpha-2 country code.
+func isCountryCode(value string) bool {
+	if len(value) != 2 {
+		return false
+	}
+	for _, r := range value {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// keyGenerations returns the first and the active key generation to keep in the key group.
+func (r *FileHostingKeyRotationArgs) keyGenerations() (int, int, error) {
+	if r == nil {
+		return 1, 1, nil
+	}
+	active, generations := 1, 2
+	if r.ActiveGeneration != nil {
+		active = *r.ActiveGeneration
+	}
+	if r.Generations != nil {
+		generations = *r.Generations
+	}
+	if active < 1 {
+		return 0, 0, fmt.Errorf("keyRotation.activeGeneration must be at least 1, got %d", active)
+	}
+	if generations < 1 || generations > maxKeyGroupKeys {
+		return 0, 0, fmt.Errorf("keyRotation.generations must be between 1 and %d, got %d", maxKeyGroupKeys, generations)
+	}
+	first := active - generations + 1
+	if first < 1 {
+		first = 1
+	}
+	return first, active, nil
 }
 
 // The FileHosting component resource.
 type FileHosting struct {
 	pulumi.ResourceState
 
-	// Bucket     *s3.Bucket          `pulumi:"bucket"`
-	Url                     pulumi.StringOutput `pulumi:"url"`
-	PrivateKeyParameterName pulumi.StringOutput `pulumi:"privateKeyParameterName"`
-	PrivateKeyId            pulumi.StringOutput `pulumi:"privateKeyId"`
+	Url                           pulumi.StringOutput         `pulumi:"url"`
+	PrivateKeyParameterName       pulumi.StringOutput         `pulumi:"privateKeyParameterName"`
+	PrivateKeyId                  pulumi.StringOutput         `pulumi:"privateKeyId"`
+	UploadPrivateKeyParameterName pulumi.StringOutput         `pulumi:"uploadPrivateKeyParameterName"`
+	UploadPrivateKeyId            pulumi.StringOutput         `pulumi:"uploadPrivateKeyId"`
+	DistributionDomainName        pulumi.StringOutput         `pulumi:"distributionDomainName"`
+	DistributionId                pulumi.StringOutput         `pulumi:"distributionId"`
+	DistributionArn               pulumi.StringOutput         `pulumi:"distributionArn"`
+	BucketName                    pulumi.StringOutput         `pulumi:"bucketName"`
+	BucketArn                     pulumi.StringOutput         `pulumi:"bucketArn"`
+	KeyGroupId                    pulumi.StringOutput         `pulumi:"keyGroupId"`
+	CertificateArn                pulumi.StringOutput         `pulumi:"certificateArn"`
+	OriginAccessControlId         pulumi.StringOutput         `pulumi:"originAccessControlId"`
+	NotificationQueueArn          pulumi.StringOutput         `pulumi:"notificationQueueArn"`
+	NotificationTopicArn          pulumi.StringOutput         `pulumi:"notificationTopicArn"`
+	SignerPolicy                  pulumi.StringOutput         `pulumi:"signerPolicy"`
+	BucketReaderPolicy            pulumi.StringOutput         `pulumi:"bucketReaderPolicy"`
+	BucketWriterPolicy            pulumi.StringOutput         `pulumi:"bucketWriterPolicy"`
+	SignerPolicyArn               pulumi.StringOutput         `pulumi:"signerPolicyArn"`
+	BucketReaderPolicyArn         pulumi.StringOutput         `pulumi:"bucketReaderPolicyArn"`
+	BucketWriterPolicyArn         pulumi.StringOutput         `pulumi:"bucketWriterPolicyArn"`
+	ValidationRecords             pulumi.StringMapArrayOutput `pulumi:"validationRecords"`
+	LogBucketName                 pulumi.StringOutput         `pulumi:"logBucketName"`
 }
 
 // NewFileHosting creates a new FileHosting component resource.
@@ -39,324 +339,915 @@ func NewFileHosting(ctx *pulumi.Context,
 	if args == nil {
 		args = &FileHostingArgs{}
 	}
+	// Validate the arguments before any resources are registered.
+	if err := validateFileHostingArgs(args); err != nil {
+		return nil, err
+	}
+	firstKeyGeneration, activeKeyGeneration, err := args.KeyRotation.keyGenerations()
+	if err != nil {
+		return nil, err
+	}
+	uploadMode, err := args.uploadMode()
+	if err != nil {
+		return nil, err
+	}
+	uploadPathPattern := "uploads/*"
+	if args.UploadPathPattern != nil {
+		uploadPathPattern = strings.TrimPrefix(*args.UploadPathPattern, "/")
+	}
 
 	component := &FileHosting{}
-	err := ctx.RegisterComponentResource("gotiac:index:FileHosting", name, component, opts...)
+	err = ctx.RegisterComponentResource("gotiac:index:FileHosting", name, component, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	// Manage the bucket with a provider for its region, if it differs from the region of the stack
+	var bucketRegion pulumi.StringInput
+	var bucketOpts []pulumi.ResourceOption
+	var bucketInvokeOpts []pulumi.InvokeOption
+	if args.BucketRegion != nil {
+		bucketProvider, err := aws.NewProvider(ctx, name+"-bucket-region", &aws.ProviderArgs{
+			Region: *args.BucketRegion,
+		}, pulumi.Parent(component))
+		if err != nil {
+			return nil, err
+		}
+		bucketRegion = *args.BucketRegion
+		bucketOpts = append(bucketOpts, pulumi.Provider(bucketProvider))
+		bucketInvokeOpts = append(bucketInvokeOpts, pulumi.Provider(bucketProvider))
+	} else {
+		currentRegion, err := aws.GetRegion(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		bucketRegion = pulumi.String(currentRegion.Name)
+	}
+
 	var bucketName pulumi.StringInput
 	var bucketRegionalDomainName pulumi.StringInput
+	var bucketKey *kms.Key
+	var bucketKmsKeyArn pulumi.StringInput
+	var bucketVersioning []pulumi.Resource
 	if args.BucketName != nil {
 		bucketName = *args.BucketName
 		// Look up the bucket regional domain name
-		bucketRegionalDomainName = (*args.BucketName).ToStringOutput().ApplyT(func(name string) (string, error) {
-			bucket, err := s3.LookupBucket(ctx, &s3.LookupBucketArgs{
-				Bucket: name,
-			})
-			if err != nil {
-				return "", err
-			}
-			return bucket.BucketRegionalDomainName, nil
-		}).(pulumi.StringOutput)
+		bucketRegionalDomainName = lookUpBucketRegionalDomainName(ctx, *args.BucketName, bucketRegion, bucketInvokeOpts...)
+		if args.BucketKmsKeyArn != nil {
+			bucketKmsKeyArn = *args.BucketKmsKeyArn
+		}
 	} else {
-		// Create an S3 bucket to host files for the FileHosting service
-		fileHostingBucket, err := s3.NewBucket(ctx, "gotiacFileHosting", &s3.BucketArgs{})
+		fileHostingBucket, err := newFileHostingBucket(ctx, component, name, args.BucketOptions, bucketOpts...)
 		if err != nil {
 			return nil, err
 		}
-		bucketName = fileHostingBucket.Bucket
-		bucketRegionalDomainName = fileHostingBucket.BucketRegionalDomainName
+		bucketName = fileHostingBucket.bucket.Bucket
+		bucketRegionalDomainName = fileHostingBucket.bucket.BucketRegionalDomainName
+		bucketKey = fileHostingBucket.kmsKey
+		bucketKmsKeyArn = fileHostingBucket.kmsKeyArn
+		if fileHostingBucket.versioning != nil {
+			bucketVersioning = append(bucketVersioning, fileHostingBucket.versioning)
+		}
 	}
 
-	if _, err = s3.NewBucketOwnershipControls(ctx, "fileHostingBucketOwnerShipControls", &s3.BucketOwnershipControlsArgs{
-		Bucket: bucketName,
-		Rule: &s3.BucketOwnershipControlsRuleArgs{
-			ObjectOwnership: pulumi.String("BucketOwnerEnforced"),
-		},
-	}); err != nil {
-		return nil, err
+	// Buckets owned by other systems may keep their own ownership controls and public access block.
+	if args.ManageOwnershipControls == nil || *args.ManageOwnershipControls {
+		if _, err = s3.NewBucketOwnershipControls(ctx, name+"-ownership-controls", &s3.BucketOwnershipControlsArgs{
+			Bucket: bucketName,
+			Rule: &s3.BucketOwnershipControlsRuleArgs{
+				ObjectOwnership: pulumi.String("BucketOwnerEnforced"),
+			},
+		}, fileHostingChildOptions(component, "fileHostingBucketOwnerShipControls", bucketOpts...)...); err != nil {
+			return nil, err
+		}
 	}
 
 	// Creat public access block configuration to block public access to the bucket.
-	if _, err := s3.NewBucketPublicAccessBlock(ctx, "fileHostingBucketPublicAccessBlock", &s3.BucketPublicAccessBlockArgs{
-		Bucket:                bucketName,
-		BlockPublicPolicy:     pulumi.Bool(true),
-		BlockPublicAcls:       pulumi.Bool(true),
-		IgnorePublicAcls:      pulumi.Bool(true),
-		RestrictPublicBuckets: pulumi.Bool(true),
-	}); err != nil {
-		return nil, err
+	if args.ManagePublicAccessBlock == nil || *args.ManagePublicAccessBlock {
+		if _, err := s3.NewBucketPublicAccessBlock(ctx, name+"-public-access-block", &s3.BucketPublicAccessBlockArgs{
+			Bucket:                bucketName,
+			BlockPublicPolicy:     pulumi.Bool(true),
+			BlockPublicAcls:       pulumi.Bool(true),
+			IgnorePublicAcls:      pulumi.Bool(true),
+			RestrictPublicBuckets: pulumi.Bool(true),
+		}, fileHostingChildOptions(component, "fileHostingBucketPublicAccessBlock", bucketOpts...)...); err != nil {
+			return nil, err
+		}
 	}
 
-	// Create an ACM certificate for the domain
-	usEast1, err := aws.NewProvider(ctx, "us-east-1", &aws.ProviderArgs{
-		Region: pulumi.String("us-east-1"),
-	})
-	if err != nil {
-		return nil, err
+	// Create or look up the bucket to fail over to and replicate the files to it
+	var failover *failoverBucket
+	if args.Failover != nil {
+		failover, err = newFileHostingFailoverBucket(ctx, component, name, args.Failover)
+		if err != nil {
+			return nil, err
+		}
+		if args.Failover.Replication != nil && *args.Failover.Replication {
+			if err := newFileHostingReplication(ctx, component, name, bucketName, failover, bucketVersioning, bucketOpts...); err != nil {
+				return nil, err
+			}
+		}
 	}
-	// convert the domain to a string
-	certificate, err := acm.NewCertificate(ctx, "gotiacFileHostingCertificate", &acm.CertificateArgs{
-		DomainName:       args.Domain,
-		ValidationMethod: pulumi.String("DNS"),
-	}, pulumi.Provider(usEast1))
+
+	// Create a provider for us-east-1, where CloudFront expects its certificates
+	usEast1, err := aws.NewProvider(ctx, name+"-us-east-1", &aws.ProviderArgs{
+		Region: pulumi.String("us-east-1"),
+	}, fileHostingChildOptions(component, "us-east-1")...)
 	if err != nil {
 		return nil, err
 	}
-	// Look up the hosted zone for the domain
-	hostedZoneId := lookUpHostedZone(ctx, args.Domain)
-	// Use the Route 53 HostedZone ID and Record Name/Type from the certificate's DomainValidationOptions to create a DNS record
-	validationRecord := certificate.DomainValidationOptions.Index(pulumi.Int(0))
-	// Create a Route 53 record set for the domain
-	validationRecordEntry, err := route53.NewRecord(ctx, "gotiacFileHostingCertificateValidationRecord", &route53.RecordArgs{
-		Name:   validationRecord.ResourceRecordName().Elem(),
-		Type:   validationRecord.ResourceRecordType().Elem(),
-		ZoneId: hostedZoneId,
-		Ttl:    pulumi.Int(300),
-		Records: pulumi.StringArray{
-			validationRecord.ResourceRecordValue().Elem(),
-		},
-	}, pulumi.Provider(usEast1))
-	if err != nil {
-		return nil, err
+	domain := pulumi.String(args.Domain)
+	manageDns := args.ManageDns == nil || *args.ManageDns
+	ipv6Enabled := args.Ipv6Enabled == nil || *args.Ipv6Enabled
+	var hostedZoneId pulumi.StringInput
+	aliasHostedZoneIds := map[string]pulumi.StringInput{}
+	if manageDns {
+		hostedZoneId = resolveHostedZone(ctx, domain, args.HostedZoneId)
+		for _, alias := range args.Aliases {
+			aliasHostedZoneIds[alias] = resolveHostedZone(ctx, pulumi.String(strings.TrimPrefix(alias, "*.")), args.HostedZoneId)
+		}
 	}
 
-	// Create a validation object that encapsulates the certificate and its validation DNS entry
-	certificateValidation, err := acm.NewCertificateValidation(ctx, "certValidation", &acm.CertificateValidationArgs{
-		CertificateArn: certificate.Arn,
-	}, pulumi.Provider(usEast1), pulumi.DependsOn([]pulumi.Resource{certificate, validationRecordEntry}))
-	if err != nil {
-		return nil, err
+	var certificateArn pulumi.StringInput
+	var certificateDependencies []pulumi.Resource
+	validationRecords := pulumi.StringMapArray{}.ToStringMapArrayOutput()
+	if args.CertificateArn != nil {
+		// Use the existing certificate as is, it has to be issued in us-east-1.
+		certificateArn = *args.CertificateArn
+	} else {
+		// Create an ACM certificate for the domain and its aliases
+		certificateArgs := &acm.CertificateArgs{
+			DomainName:       domain,
+			ValidationMethod: pulumi.String("DNS"),
+		}
+		if len(args.Aliases) > 0 {
+			certificateArgs.SubjectAlternativeNames = pulumi.ToStringArray(args.Aliases)
+		}
+		certificate, err := acm.NewCertificate(ctx, name+"-certificate", certificateArgs,
+			fileHostingChildOptions(component, "gotiacFileHostingCertificate", pulumi.Provider(usEast1))...)
+		if err != nil {
+			return nil, err
+		}
+		validationDependencies := []pulumi.Resource{certificate}
+		if manageDns {
+			// Use the Route 53 HostedZone ID and Record Name/Type from the certificate's DomainValidationOptions to create a DNS record
+			validationRecord := certificateValidationOption(certificate, domain)
+			// Create a Route 53 record set for the domain
+			validationRecordEntry, err := route53.NewRecord(ctx, name+"-certificate-validation-record", &route53.RecordArgs{
+				Name:   validationRecord.ResourceRecordName().Elem(),
+				Type:   validationRecord.ResourceRecordType().Elem(),
+				ZoneId: hostedZoneId,
+				Ttl:    pulumi.Int(300),
+				Records: pulumi.StringArray{
+					validationRecord.ResourceRecordValue().Elem(),
+				},
+				AllowOverwrite: pulumi.Bool(true),
+			}, fileHostingChildOptions(component, "gotiacFileHostingCertificateValidationRecord", pulumi.Provider(usEast1))...)
+			if err != nil {
+				return nil, err
+			}
+			validationDependencies = append(validationDependencies, validationRecordEntry)
+
+			// Create one validation record per alias. ACM gives a wildcard the validation record of
+			// its apex domain, so aliases sharing the record of the domain or of an earlier alias
+			// are skipped.
+			validatedDomains := map[string]bool{
+				strings.TrimPrefix(args.Domain, "*."): true,
+			}
+			for _, alias := range args.Aliases {
+				validatedDomain := strings.TrimPrefix(alias, "*.")
+				if validatedDomains[validatedDomain] {
+					continue
+				}
+				validatedDomains[validatedDomain] = true
+
+				validationRecord := certificateValidationOption(certificate, pulumi.String(alias))
+				validationRecordEntry, err := route53.NewRecord(ctx, name+"-certificate-validation-record-"+validatedDomain, &route53.RecordArgs{
+					Name:   validationRecord.ResourceRecordName().Elem(),
+					Type:   validationRecord.ResourceRecordType().Elem(),
+					ZoneId: aliasHostedZoneIds[alias],
+					Ttl:    pulumi.Int(300),
+					Records: pulumi.StringArray{
+						validationRecord.ResourceRecordValue().Elem(),
+					},
+					AllowOverwrite: pulumi.Bool(true),
+				}, pulumi.Parent(component), pulumi.Provider(usEast1))
+				if err != nil {
+					return nil, err
+				}
+				validationDependencies = append(validationDependencies, validationRecordEntry)
+			}
+		} else {
+			// Return the validation records to create in the external DNS provider.
+			validationRecords = certificate.DomainValidationOptions.ApplyT(certificateValidationRecords).(pulumi.StringMapArrayOutput)
+
+			// The certificate can only be validated once the records exist, so the first update
+			// stops here and returns them. The distribution is created by the update after
+			// certificateValidated has been set.
+			if args.CertificateValidated == nil || !*args.CertificateValidated {
+				component.setPendingOutputs(domain, bucketName, certificate.Arn, validationRecords)
+				if err := component.registerOutputs(ctx); err != nil {
+					return nil, err
+				}
+				return component, nil
+			}
+		}
+
+		// Create a validation object that encapsulates the certificate and its validation DNS entry.
+		// Without managed DNS this waits until ACM has seen the externally created records.
+		certificateValidation, err := acm.NewCertificateValidation(ctx, name+"-certificate-validation", &acm.CertificateValidationArgs{
+			CertificateArn: certificate.Arn,
+		}, fileHostingChildOptions(component, "certValidation", pulumi.Provider(usEast1), pulumi.DependsOn(validationDependencies))...)
+		if err != nil {
+			return nil, err
+		}
+		certificateArn = certificate.Arn
+		certificateDependencies = []pulumi.Resource{certificateValidation}
 	}
 
 	// Create an origin access control for the CloudFront distribution
-	originAccessControl, err := cloudfront.NewOriginAccessControl(ctx, "gotiacFileHostingOriginAccessControl", &cloudfront.OriginAccessControlArgs{
+	originAccessControl, err := cloudfront.NewOriginAccessControl(ctx, name+"-origin-access-control", &cloudfront.OriginAccessControlArgs{
 		Description:                   pulumi.String("Origin Access Control for FileHosting"),
 		OriginAccessControlOriginType: pulumi.String("s3"),
 		SigningBehavior:               pulumi.String("always"),
 		SigningProtocol:               pulumi.String("sigv4"),
-	})
+	}, fileHostingChildOptions(component, "gotiacFileHostingOriginAccessControl")...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create a cache policy for the CloudFront distribution
-	cachePolicy, err := cloudfront.NewCachePolicy(ctx, "gotiacFileHostingCachePolicy", &cloudfront.CachePolicyArgs{
-		DefaultTtl: pulumi.Int(86400),
-		MaxTtl:     pulumi.Int(31536000),
-		MinTtl:     pulumi.Int(1),
-		ParametersInCacheKeyAndForwardedToOrigin: cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginArgs{
-			CookiesConfig: &cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginCookiesConfigArgs{
-				CookieBehavior: pulumi.String("none"),
-			},
-			EnableAcceptEncodingBrotli: pulumi.Bool(false),
-			EnableAcceptEncodingGzip:   pulumi.Bool(false),
-			HeadersConfig: &cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginHeadersConfigArgs{
-				HeaderBehavior: pulumi.String("none"),
-			},
-			QueryStringsConfig: &cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginQueryStringsConfigArgs{
-				QueryStringBehavior: pulumi.String("whitelist"),
-				QueryStrings: &cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginQueryStringsConfigQueryStringsArgs{
-					Items: pulumi.StringArray{
-						pulumi.String("etag"),
-					},
-				},
-			},
-		},
-	})
-	if err != nil {
-		return nil, err
+	// Create a cache policy for the CloudFront distribution, unless an existing one is shared
+	var cachePolicyId pulumi.StringInput
+	if args.CachePolicyId != nil {
+		cachePolicyId = *args.CachePolicyId
+	} else {
+		cachePolicy, err := cloudfront.NewCachePolicy(ctx, name+"-cache-policy", args.CachePolicy.cachePolicyArgs(),
+			fileHostingChildOptions(component, "gotiacFileHostingCachePolicy")...)
+		if err != nil {
+			return nil, err
+		}
+		cachePolicyId = cachePolicy.ID()
 	}
 
-	// Create an origin request policy for the CloudFront distribution
-	originRequestPolicy, err := cloudfront.NewOriginRequestPolicy(ctx, "gotiacFileHostingOriginRequestPolicy", &cloudfront.OriginRequestPolicyArgs{
-		CookiesConfig: &cloudfront.OriginRequestPolicyCookiesConfigArgs{
-			CookieBehavior: pulumi.String("none"),
-		},
-		HeadersConfig: &cloudfront.OriginRequestPolicyHeadersConfigArgs{
-			HeaderBehavior: pulumi.String("whitelist"),
-			Headers: &cloudfront.OriginRequestPolicyHeadersConfigHeadersArgs{
-				Items: pulumi.StringArray{
-					pulumi.String("Content-Type"),
-				},
-			},
-		},
-		QueryStringsConfig: &cloudfront.OriginRequestPolicyQueryStringsConfigArgs{
-			QueryStringBehavior: pulumi.String("whitelist"),
-			QueryStrings: &cloudfront.OriginRequestPolicyQueryStringsConfigQueryStringsArgs{
-				Items: pulumi.StringArray{
-					pulumi.String("partNumber"),
-					pulumi.String("uploadId"),
-				},
-			},
-		},
-	})
-	if err != nil {
-		return nil, err
+	// Create an origin request policy for the CloudFront distribution, unless an existing one is shared
+	var originRequestPolicyId pulumi.StringInput
+	if args.OriginRequestPolicyId != nil {
+		originRequestPolicyId = *args.OriginRequestPolicyId
+	} else {
+		originRequestPolicy, err := cloudfront.NewOriginRequestPolicy(ctx, name+"-origin-request-policy", args.OriginRequestPolicy.originRequestPolicyArgs(),
+			fileHostingChildOptions(component, "gotiacFileHostingOriginRequestPolicy")...)
+		if err != nil {
+			return nil, err
+		}
+		originRequestPolicyId = originRequestPolicy.ID()
 	}
 
-	// Generate RSA Public/Private Key Pair for CloudFront Trusted Key Groups using tls package
-	privateRsaKey, err := tls.NewPrivateKey(ctx, "gotiacFileHostingPrivateRsaKey", &tls.PrivateKeyArgs{
-		RsaBits:   pulumi.Int(2048),
-		Algorithm: pulumi.String("RSA"),
-	})
+	// Create a response headers policy with the CORS settings and security headers
+	responseHeadersPolicy, err := cloudfront.NewResponseHeadersPolicy(ctx, name+"-response-headers-policy",
+		responseHeadersPolicyArgs(args.Cors, args.SecurityHeaders), pulumi.Parent(component))
 	if err != nil {
 		return nil, err
 	}
-	publicRsaKey := tls.GetPublicKeyOutput(ctx, tls.GetPublicKeyOutputArgs{
-		PrivateKeyPem: privateRsaKey.PrivateKeyPem,
-	})
 
-	// // Create a public key for the CloudFront distribution
-	publicKey, err := cloudfront.NewPublicKey(ctx, "gotiacFileHostingPublicKey", &cloudfront.PublicKeyArgs{
-		EncodedKey: publicRsaKey.PublicKeyPem(),
-	})
+	// Create Key Group for the CloudFront distribution
+	keyGroup, keys, err := newFileHostingKeyGroup(ctx, component, name, firstKeyGeneration, activeKeyGeneration, true)
 	if err != nil {
 		return nil, err
 	}
+	activeKey := keys[len(keys)-1]
+	// The signer may read every key that is still trusted by a key group, so that signers holding
+	// the previous key keep working while a rotation is rolled out.
+	keyParameterArns := signingKeyParameterArns(keys)
 
-	// Create Key Group for the CloudFront distribution
-	keyGroup, err := cloudfront.NewKeyGroup(ctx, "gotiacFileHostingKeyGroup", &cloudfront.KeyGroupArgs{
-		Items: pulumi.StringArray{
-			publicKey.ID(),
-		},
-	})
+	// With failover, reads go through the origin group. Uploads always target the bucket, as origin
+	// groups do not accept writes.
+	targetOriginId := primaryOriginId
+	if failover != nil {
+		targetOriginId = originGroupId
+	}
+
+	// Uploads through the default behavior are only allowed in full mode. In signed-upload mode
+	// writes go through their own behavior, which trusts a separate key group.
+	defaultAllowedMethods := readMethods()
+	if uploadMode == uploadModeFull {
+		defaultAllowedMethods = allMethods()
+	}
+	// Create the CloudFront Functions of the default cache behavior. The upload behavior runs them
+	// too, so checks of the default behavior cannot be bypassed through the upload path.
+	defaultEdgeHandlers, err := newEdgeHandlers(ctx, component, name, args.EdgeHandlers)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create SSM paramters for the private key and cloudfront access key id
-	fileHostingKeyParameter, err := ssm.NewParameter(ctx, "gotiacFileHostingPrivateKey", &ssm.ParameterArgs{
-		Type:  pulumi.String("SecureString"),
-		Value: privateRsaKey.PrivateKeyPem,
-	})
+	orderedCacheBehaviors := cloudfront.DistributionOrderedCacheBehaviorArray{}
+	uploadPrivateKeyParameterName := pulumi.String("").ToStringOutput()
+	uploadPrivateKeyId := pulumi.String("").ToStringOutput()
+	if uploadMode == uploadModeSignedUpload {
+		uploadKeyGroup, uploadKeys, err := newFileHostingKeyGroup(ctx, component, name+"-upload", firstKeyGeneration, activeKeyGeneration, false)
+		if err != nil {
+			return nil, err
+		}
+		uploadKey := uploadKeys[len(uploadKeys)-1]
+		orderedCacheBehaviors = append(orderedCacheBehaviors, &cloudfront.DistributionOrderedCacheBehaviorArgs{
+			PathPattern:    pulumi.String(uploadPathPattern),
+			AllowedMethods: allMethods(),
+			CachedMethods: pulumi.StringArray{
+				pulumi.String("GET"),
+				pulumi.String("HEAD"),
+			},
+			TargetOriginId:          pulumi.String(primaryOriginId),
+			ViewerProtocolPolicy:    pulumi.String("redirect-to-https"),
+			CachePolicyId:           cachePolicyId,
+			OriginRequestPolicyId:   originRequestPolicyId,
+			ResponseHeadersPolicyId: responseHeadersPolicy.ID(),
+			Compress:                pulumi.Bool(true),
+			TrustedKeyGroups: pulumi.StringArray{
+				uploadKeyGroup.ID(),
+			},
+			FunctionAssociations:       defaultEdgeHandlers.orderedCacheBehaviorFunctionAssociations(),
+			LambdaFunctionAssociations: defaultEdgeHandlers.orderedCacheBehaviorLambdaAssociations(),
+		})
+		uploadPrivateKeyParameterName = uploadKey.parameter.Name
+		keyParameterArns = append(keyParameterArns, signingKeyParameterArns(uploadKeys)...)
+		uploadPrivateKeyId = pulumi.StringOutput(uploadKey.publicKey.ID())
+	}
+
+	// Create the cache behaviors for the configured path patterns
+	for i, behavior := range args.CacheBehaviors {
+		behaviorEdgeHandlers, err := newEdgeHandlers(ctx, component, fmt.Sprintf("%s-behavior-%d", name, i), behavior.EdgeHandlers)
+		if err != nil {
+			return nil, err
+		}
+		var allowedMethods pulumi.StringArrayInput = readMethods()
+		if behavior.AllowedMethods != nil {
+			allowedMethods = *behavior.AllowedMethods
+		}
+		// Origin groups only accept GET, HEAD and OPTIONS, so behaviors allowing writes target the
+		// bucket directly and go without failover.
+		behaviorTargetOriginId := allowedMethods.ToStringArrayOutput().ApplyT(func(methods []string) string {
+			for _, method := range methods {
+				if !slices.Contains([]string{"GET", "HEAD", "OPTIONS"}, method) {
+					return primaryOriginId
+				}
+			}
+			return targetOriginId
+		}).(pulumi.StringOutput)
+		var behaviorCachePolicyId pulumi.StringInput = cachePolicyId
+		if behavior.CachePolicyId != nil {
+			behaviorCachePolicyId = *behavior.CachePolicyId
+		}
+		var trustedKeyGroups pulumi.StringArrayInput
+		if behavior.Signed == nil || *behavior.Signed {
+			trustedKeyGroups = pulumi.StringArray{
+				keyGroup.ID(),
+			}
+		}
+		orderedCacheBehaviors = append(orderedCacheBehaviors, &cloudfront.DistributionOrderedCacheBehaviorArgs{
+			PathPattern:    behavior.PathPattern,
+			AllowedMethods: allowedMethods,
+			CachedMethods: pulumi.StringArray{
+				pulumi.String("GET"),
+				pulumi.String("HEAD"),
+			},
+			TargetOriginId:             behaviorTargetOriginId,
+			ViewerProtocolPolicy:       pulumi.String("redirect-to-https"),
+			CachePolicyId:              behaviorCachePolicyId,
+			OriginRequestPolicyId:      originRequestPolicyId,
+			ResponseHeadersPolicyId:    responseHeadersPolicy.ID(),
+			Compress:                   pulumi.Bool(true),
+			TrustedKeyGroups:           trustedKeyGroups,
+			FunctionAssociations:       behaviorEdgeHandlers.orderedCacheBehaviorFunctionAssociations(),
+			LambdaFunctionAssociations: behaviorEdgeHandlers.orderedCacheBehaviorLambdaAssociations(),
+		})
+	}
+
+	callerIdentity, err := aws.GetCallerIdentity(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	// Write CloudFront standard logs and S3 server access logs to the log bucket
+	var loggingConfig cloudfront.DistributionLoggingConfigPtrInput
+	distributionDependencies := certificateDependencies
+	logBucketName := pulumi.String("").ToStringOutput()
+	if args.Logging != nil {
+		logBucket, err := newFileHostingLogBucket(ctx, component, name, args.Logging, callerIdentity.AccountId, bucketOpts...)
+		if err != nil {
+			return nil, err
+		}
+		var cloudFrontPrefix pulumi.StringInput = pulumi.String("cloudfront/")
+		if args.Logging.CloudFrontPrefix != nil {
+			cloudFrontPrefix = *args.Logging.CloudFrontPrefix
+		}
+		var s3Prefix pulumi.StringInput = pulumi.String("s3/")
+		if args.Logging.S3Prefix != nil {
+			s3Prefix = *args.Logging.S3Prefix
+		}
+		var includeCookies pulumi.BoolInput = pulumi.Bool(false)
+		if args.Logging.IncludeCookies != nil {
+			includeCookies = *args.Logging.IncludeCookies
+		}
+		loggingConfig = &cloudfront.DistributionLoggingConfigArgs{
+			Bucket:         logBucket.domainName,
+			IncludeCookies: includeCookies,
+			Prefix:         cloudFrontPrefix,
+		}
+		distributionDependencies = append(distributionDependencies, logBucket.dependencies...)
+		if _, err := s3.NewBucketLoggingV2(ctx, name+"-bucket-logging", &s3.BucketLoggingV2Args{
+			Bucket:       bucketName,
+			TargetBucket: logBucket.name,
+			TargetPrefix: s3Prefix,
+		}, append([]pulumi.ResourceOption{pulumi.Parent(component)}, bucketOpts...)...); err != nil {
+			return nil, err
+		}
+		logBucketName = logBucket.name
+	}
+
+	// Attach the given web ACL or create one
+	var webAclArn pulumi.StringPtrInput
+	if args.WebAclArn != nil {
+		webAclArn = *args.WebAclArn
+	} else if args.WebAcl != nil {
+		webAcl, err := newWebAcl(ctx, name+"-web-acl", args.WebAcl, pulumi.Parent(component), pulumi.Provider(usEast1))
+		if err != nil {
+			return nil, err
+		}
+		webAclArn = webAcl.Arn
+	}
+
+	priceClass := "PriceClass_All"
+	if args.PriceClass != nil {
+		priceClass = *args.PriceClass
+	}
+	geoRestriction := &cloudfront.DistributionRestrictionsGeoRestrictionArgs{
+		RestrictionType: pulumi.String("none"),
+	}
+	if args.GeoRestriction != nil {
+		geoRestriction = &cloudfront.DistributionRestrictionsGeoRestrictionArgs{
+			RestrictionType: pulumi.String(args.GeoRestriction.RestrictionType),
+			Locations:       pulumi.ToStringArray(args.GeoRestriction.Locations),
+		}
+	}
+
+	origins := cloudfront.DistributionOriginArray{
+		distributionOrigin(primaryOriginId, bucketRegionalDomainName, originAccessControl.ID(), args.OriginShieldRegion),
+	}
+	var originGroups cloudfront.DistributionOriginGroupArray
+	if failover != nil {
+		origins = append(origins, distributionOrigin(failoverOriginId, failover.regionalDomainName, originAccessControl.ID(), args.OriginShieldRegion))
+		originGroups = cloudfront.DistributionOriginGroupArray{
+			distributionOriginGroup(args.Failover.StatusCodes),
+		}
+	}
+
 	// Attach a bucket policy that allows CloudFront to read from the bucket
 	// Set up a CloudFront distribution to serve the hosted files
-	distribution, err := cloudfront.NewDistribution(ctx, "gotiacFileHostingDistribution", &cloudfront.DistributionArgs{
-		Aliases: pulumi.StringArray{
-			args.Domain,
-		},
-		Origins: cloudfront.DistributionOriginArray{
-			&cloudfront.DistributionOriginArgs{
-				DomainName:            bucketRegionalDomainName,
-				OriginId:              pulumi.String("S3-origin"),
-				OriginAccessControlId: originAccessControl.ID(),
-			},
-		},
+	distribution, err := cloudfront.NewDistribution(ctx, name+"-distribution", &cloudfront.DistributionArgs{
+		Aliases: append(pulumi.StringArray{
+			domain,
+		}, pulumi.ToStringArray(args.Aliases)...),
+		Origins:       origins,
+		OriginGroups:  originGroups,
 		Enabled:       pulumi.Bool(true),
-		IsIpv6Enabled: pulumi.Bool(true),
+		IsIpv6Enabled: pulumi.Bool(ipv6Enabled),
 		Comment:       pulumi.String("FileHosting distribution"),
 		DefaultCacheBehavior: &cloudfront.DistributionDefaultCacheBehaviorArgs{
-			AllowedMethods: pulumi.StringArray{
-				pulumi.String("GET"),
-				pulumi.String("PUT"),
-				pulumi.String("POST"),
-				pulumi.String("PATCH"),
-				pulumi.String("DELETE"),
-				pulumi.String("HEAD"),
-				pulumi.String("OPTIONS"),
-			},
+			AllowedMethods: defaultAllowedMethods,
 			CachedMethods: pulumi.StringArray{
 				pulumi.String("GET"),
 				pulumi.String("HEAD"),
 			},
-			TargetOriginId:          pulumi.String("S3-origin"),
+			TargetOriginId:          pulumi.String(targetOriginId),
 			ViewerProtocolPolicy:    pulumi.String("redirect-to-https"),
-			CachePolicyId:           cachePolicy.ID(),
-			OriginRequestPolicyId:   originRequestPolicy.ID(),
-			ResponseHeadersPolicyId: pulumi.String("5cc3b908-e619-4b99-88e5-2cf7f45965bd"), // CORS with Preflight
+			CachePolicyId:           cachePolicyId,
+			OriginRequestPolicyId:   originRequestPolicyId,
+			ResponseHeadersPolicyId: responseHeadersPolicy.ID(),
 			Compress:                pulumi.Bool(true),
 			TrustedKeyGroups: pulumi.StringArray{
 				keyGroup.ID(),
 			},
+			FunctionAssociations:       defaultEdgeHandlers.defaultCacheBehaviorFunctionAssociations(),
+			LambdaFunctionAssociations: defaultEdgeHandlers.defaultCacheBehaviorLambdaAssociations(),
 		},
-		PriceClass: pulumi.String("PriceClass_All"),
+		OrderedCacheBehaviors: orderedCacheBehaviors,
+		LoggingConfig:         loggingConfig,
+		WebAclId:              webAclArn,
+		PriceClass:            pulumi.String(priceClass),
 		ViewerCertificate: &cloudfront.DistributionViewerCertificateArgs{
-			AcmCertificateArn:      certificate.Arn,
+			AcmCertificateArn:      certificateArn,
 			SslSupportMethod:       pulumi.String("sni-only"),
 			MinimumProtocolVersion: pulumi.String("TLSv1.2_2021"),
 		},
 		Restrictions: &cloudfront.DistributionRestrictionsArgs{
-			GeoRestriction: &cloudfront.DistributionRestrictionsGeoRestrictionArgs{
-				RestrictionType: pulumi.String("none"),
-			},
+			GeoRestriction: geoRestriction,
 		},
-	}, pulumi.DependsOn([]pulumi.Resource{certificateValidation}))
+	}, fileHostingChildOptions(component, "gotiacFileHostingDistribution", pulumi.DependsOn(distributionDependencies))...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create a route53 record set for the domain.
-	if _, err := route53.NewRecord(ctx, "gotiacFileHostingRecord", &route53.RecordArgs{
-		Name:   args.Domain,
-		Type:   pulumi.String("A"),
-		ZoneId: hostedZoneId,
-		Aliases: route53.RecordAliasArray{
-			&route53.RecordAliasArgs{
-				Name:                 distribution.DomainName,
-				ZoneId:               distribution.HostedZoneId,
-				EvaluateTargetHealth: pulumi.Bool(true),
-			},
-		},
-	}, pulumi.DependsOn([]pulumi.Resource{distribution})); err != nil {
+	// Create a route53 record set for the domain and each alias.
+	if manageDns {
+		if err := newDistributionAliasRecords(ctx, component, name+"-record", "gotiacFileHostingRecord",
+			domain, hostedZoneId, distribution, ipv6Enabled); err != nil {
+			return nil, err
+		}
+		for _, alias := range args.Aliases {
+			if err := newDistributionAliasRecords(ctx, component, name+"-record-"+alias, "",
+				pulumi.String(alias), aliasHostedZoneIds[alias], distribution, ipv6Enabled); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Create Bucket policy. Writes are only granted when uploads are enabled, in signed-upload
+	// mode only below the upload path.
+	distributionArn := pulumi.Sprintf("arn:aws:cloudfront::%s:distribution/%s", callerIdentity.AccountId, distribution.ID())
+	if bucketKey != nil {
+		if err := newFileHostingKeyPolicy(ctx, name, bucketKey, callerIdentity.AccountId, distributionArn); err != nil {
+			return nil, err
+		}
+	}
+	readActions := []interface{}{"s3:GetObject"}
+	writeActions := []interface{}{"s3:PutObject", "s3:AbortMultipartUpload"}
+	sidPrefix := bucketPolicySidPrefix(name)
+	var statements []map[string]interface{}
+	switch uploadMode {
+	case uploadModeFull:
+		statements = []map[string]interface{}{
+			cloudFrontBucketStatement(sidPrefix+"ReadWrite", append(readActions, writeActions...), pulumi.Sprintf("arn:aws:s3:::%s/*", bucketName), distributionArn),
+		}
+	case uploadModeSignedUpload:
+		statements = []map[string]interface{}{
+			cloudFrontBucketStatement(sidPrefix+"Read", readActions, pulumi.Sprintf("arn:aws:s3:::%s/*", bucketName), distributionArn),
+			cloudFrontBucketStatement(sidPrefix+"Upload", writeActions, pulumi.Sprintf("arn:aws:s3:::%s/%s", bucketName, uploadPathPattern), distributionArn),
+		}
+	default:
+		statements = []map[string]interface{}{
+			cloudFrontBucketStatement(sidPrefix+"Read", readActions, pulumi.Sprintf("arn:aws:s3:::%s/*", bucketName), distributionArn),
+		}
+	}
+	var bucketPolicy pulumi.Input = pulumi.Any(map[string]interface{}{
+		"Version":   "2012-10-17",
+		"Statement": statements,
+	})
+	bucketPolicyOpts := fileHostingChildOptions(component, "bucketPolicy", bucketOpts...)
+	if args.BucketPolicyMode != nil && *args.BucketPolicyMode == bucketPolicyModeMerge {
+		// Keep the statements of other systems. The policy is retained on delete, as deleting the
+		// resource would remove the whole policy, including the statements of other systems.
+		bucketPolicy = mergeBucketPolicy(ctx, bucketName, sidPrefix, statements, bucketInvokeOpts...)
+		bucketPolicyOpts = append(bucketPolicyOpts, pulumi.RetainOnDelete(true))
+	}
+	if _, err := s3.NewBucketPolicy(ctx, name+"-bucket-policy", &s3.BucketPolicyArgs{
+		Bucket: bucketName,
+		Policy: bucketPolicy,
+	}, bucketPolicyOpts...); err != nil {
 		return nil, err
 	}
 
-	callerIdentity, err := aws.GetCallerIdentity(ctx, nil)
+	// Allow CloudFront to read from the failover bucket
+	if failover != nil {
+		failoverStatements := []map[string]interface{}{
+			cloudFrontBucketStatement(sidPrefix+"FailoverRead", readActions, pulumi.Sprintf("arn:aws:s3:::%s/*", failover.name), distributionArn),
+		}
+		var failoverPolicy pulumi.Input = pulumi.Any(map[string]interface{}{
+			"Version":   "2012-10-17",
+			"Statement": failoverStatements,
+		})
+		failoverPolicyOpts := append([]pulumi.ResourceOption{pulumi.Parent(component)}, failover.opts...)
+		if args.Failover.BucketName != nil && args.BucketPolicyMode != nil && *args.BucketPolicyMode == bucketPolicyModeMerge {
+			failoverPolicy = mergeBucketPolicy(ctx, failover.name, sidPrefix, failoverStatements, failover.invokeOpts...)
+			failoverPolicyOpts = append(failoverPolicyOpts, pulumi.RetainOnDelete(true))
+		}
+		if _, err := s3.NewBucketPolicy(ctx, name+"-failover-bucket-policy", &s3.BucketPolicyArgs{
+			Bucket: failover.name,
+			Policy: failoverPolicy,
+		}, failoverPolicyOpts...); err != nil {
+			return nil, err
+		}
+	}
+
+	// Send the event notifications of the bucket to the configured destination
+	notificationQueueArn := pulumi.String("").ToStringOutput()
+	notificationTopicArn := pulumi.String("").ToStringOutput()
+	if args.Notifications != nil {
+		notifications, err := newFileHostingNotifications(ctx, component, name, args.Notifications,
+			bucketName, callerIdentity.AccountId, bucketOpts...)
+		if err != nil {
+			return nil, err
+		}
+		notificationQueueArn = notifications.queueArn
+		notificationTopicArn = notifications.topicArn
+	}
+
+	// Return the IAM policies of the roles using the file hosting and create them if requested
+	policies, err := newFileHostingIamPolicies(ctx, component, name, keyParameterArns, bucketName, bucketKmsKeyArn,
+		args.ManagedPolicies != nil && *args.ManagedPolicies)
 	if err != nil {
 		return nil, err
 	}
-	// Create Bucket policy
-	if _, err := s3.NewBucketPolicy(ctx, "bucketPolicy", &s3.BucketPolicyArgs{
-		Bucket: bucketName,
-		Policy: pulumi.Any(map[string]interface{}{
-			"Version": "2012-10-17",
-			"Statement": []map[string]interface{}{
-				{
-					"Effect": "Allow",
-					"Principal": map[string]interface{}{
-						"Service": "cloudfront.amazonaws.com",
-					},
-					"Action": []interface{}{
-						"s3:GetObject",
-						"s3:PutObject",
-					},
-					"Resource": []interface{}{
-						pulumi.Sprintf("arn:aws:s3:::%s/*", bucketName), // policy refers to bucket name explicitly
-					},
-					"Condition": map[string]interface{}{
-						"StringEquals": map[string]interface{}{
-							"AWS:SourceArn": pulumi.Sprintf("arn:aws:cloudfront::%s:distribution/%s", callerIdentity.AccountId, distribution.ID()),
-						},
-					},
-				},
-			},
-		}),
-	}); err != nil {
+
+	component.PrivateKeyParameterName = activeKey.parameter.Name
+	component.PrivateKeyId = pulumi.StringOutput(activeKey.publicKey.ID())
+	component.UploadPrivateKeyParameterName = uploadPrivateKeyParameterName
+	component.UploadPrivateKeyId = uploadPrivateKeyId
+	component.Url = domain.ToStringOutput()
+	component.DistributionDomainName = distribution.DomainName
+	component.DistributionId = distribution.ID().ToStringOutput()
+	component.DistributionArn = distribution.Arn
+	component.BucketName = bucketName.ToStringOutput()
+	component.BucketArn = pulumi.Sprintf("arn:aws:s3:::%s", bucketName)
+	component.KeyGroupId = keyGroup.ID().ToStringOutput()
+	component.CertificateArn = certificateArn.ToStringOutput()
+	component.OriginAccessControlId = originAccessControl.ID().ToStringOutput()
+	component.NotificationQueueArn = notificationQueueArn
+	component.NotificationTopicArn = notificationTopicArn
+	component.SignerPolicy = policies.signer
+	component.BucketReaderPolicy = policies.bucketReader
+	component.BucketWriterPolicy = policies.bucketWriter
+	component.SignerPolicyArn = policies.signerArn
+	component.BucketReaderPolicyArn = policies.bucketReaderArn
+	component.BucketWriterPolicyArn = policies.bucketWriterArn
+	component.ValidationRecords = validationRecords
+	component.LogBucketName = logBucketName
+
+	if err := component.registerOutputs(ctx); err != nil {
 		return nil, err
 	}
 
-	// component.Bucket = bucket
+	return component, nil
+}
+
+// registerOutputs registers the outputs of the FileHosting.
+func (c *FileHosting) registerOutputs(ctx *pulumi.Context) error {
+	return ctx.RegisterResourceOutputs(c, pulumi.Map{
+		"url":                           c.Url,
+		"privateKeyParameterName":       c.PrivateKeyParameterName,
+		"privateKeyId":                  c.PrivateKeyId,
+		"uploadPrivateKeyParameterName": c.UploadPrivateKeyParameterName,
+		"uploadPrivateKeyId":            c.UploadPrivateKeyId,
+		"distributionDomainName":        c.DistributionDomainName,
+		"distributionId":                c.DistributionId,
+		"distributionArn":               c.DistributionArn,
+		"bucketName":                    c.BucketName,
+		"bucketArn":                     c.BucketArn,
+		"keyGroupId":                    c.KeyGroupId,
+		"certificateArn":                c.CertificateArn,
+		"originAccessControlId":         c.OriginAccessControlId,
+		"notificationQueueArn":          c.NotificationQueueArn,
+		"notificationTopicArn":          c.NotificationTopicArn,
+		"signerPolicy":                  c.SignerPolicy,
+		"bucketReaderPolicy":            c.BucketReaderPolicy,
+		"bucketWriterPolicy":            c.BucketWriterPolicy,
+		"signerPolicyArn":               c.SignerPolicyArn,
+		"bucketReaderPolicyArn":         c.BucketReaderPolicyArn,
+		"bucketWriterPolicyArn":         c.BucketWriterPolicyArn,
+		"validationRecords":             c.ValidationRecords,
+		"logBucketName":                 c.LogBucketName,
+	})
+}
+
+// setPendingOutputs sets the outputs of a FileHosting whose certificate waits for its validation
+// records. Everything that depends on the distribution is empty until it is created.
+func (c *FileHosting) setPendingOutputs(domain, bucketName, certificateArn pulumi.StringInput,
+	validationRecords pulumi.StringMapArrayOutput) {
+	empty := pulumi.String("").ToStringOutput()
+	c.Url = domain.ToStringOutput()
+	c.PrivateKeyParameterName = empty
+	c.PrivateKeyId = empty
+	c.UploadPrivateKeyParameterName = empty
+	c.UploadPrivateKeyId = empty
+	c.DistributionDomainName = empty
+	c.DistributionId = empty
+	c.DistributionArn = empty
+	c.BucketName = bucketName.ToStringOutput()
+	c.BucketArn = pulumi.Sprintf("arn:aws:s3:::%s", bucketName)
+	c.KeyGroupId = empty
+	c.CertificateArn = certificateArn.ToStringOutput()
+	c.OriginAccessControlId = empty
+	c.NotificationQueueArn = empty
+	c.NotificationTopicArn = empty
+	c.SignerPolicy = empty
+	c.BucketReaderPolicy = empty
+	c.BucketWriterPolicy = empty
+	c.SignerPolicyArn = empty
+	c.BucketReaderPolicyArn = empty
+	c.BucketWriterPolicyArn = empty
+	c.ValidationRecords = validationRecords
+	c.LogBucketName = empty
+}
+
+// whenDistributionCreated returns the output, or fails while the distribution of the FileHosting
+// waits for its certificate to be validated. The methods of the component use it before reading
+// outputs that are empty until then.
+func (c *FileHosting) whenDistributionCreated(output pulumi.StringOutput) pulumi.StringOutput {
+	return pulumi.All(c.DistributionId, output).ApplyT(func(all []interface{}) (string, error) {
+		if all[0].(string) == "" {
+			return "", errors.New("the file hosting distribution has not been created yet, as its certificate is not validated: " +
+				"create the validationRecords and set certificateValidated")
+		}
+		return all[1].(string), nil
+	}).(pulumi.StringOutput)
+}
+
+// fileHostingChildOptions returns the options for a child resource of a FileHosting component.
+// Children used to be created at the top level of the stack under a fixed name, the alias lets
+// existing stacks adopt them without replacement.
+func fileHostingChildOptions(component pulumi.Resource, legacyName string, opts ...pulumi.ResourceOption) []pulumi.ResourceOption {
+	return append([]pulumi.ResourceOption{
+		pulumi.Parent(component),
+		pulumi.Aliases([]pulumi.Alias{
+			{Name: pulumi.String(legacyName), NoParent: pulumi.Bool(true)},
+		}),
+	}, opts...)
+}
+
+// signingKey is a key pair trusted by a CloudFront key group, whose private key is stored in SSM.
+type signingKey struct {
+	publicKey *cloudfront.PublicKey
+	parameter *ssm.Parameter
+}
+
+// newFileHostingKeyGroup creates a signing key per kept generation and the key group trusting
+// them, and returns the keys from the oldest to the active generation. Older generations stay in
+// the key group until they fall out of the rotation window, so outstanding signatures remain
+// valid. The key group and the first generation of a legacy key group keep the names FileHosting
+// used to create.
+func newFileHostingKeyGroup(ctx *pulumi.Context, component pulumi.Resource, name string,
+	firstGeneration, activeGeneration int, legacy bool) (*cloudfront.KeyGroup, []*signingKey, error) {
+	keys := []*signingKey{}
+	items := pulumi.StringArray{}
+	for generation := firstGeneration; generation <= activeGeneration; generation++ {
+		key, err := newFileHostingSigningKey(ctx, component, name, generation, legacy)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, key)
+		items = append(items, key.publicKey.ID())
+	}
+
+	keyGroupOpts := []pulumi.ResourceOption{pulumi.Parent(component)}
+	if legacy {
+		keyGroupOpts = fileHostingChildOptions(component, "gotiacFileHostingKeyGroup")
+	}
+	keyGroup, err := cloudfront.NewKeyGroup(ctx, name+"-key-group", &cloudfront.KeyGroupArgs{
+		Items: items,
+	}, keyGroupOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	component.PrivateKeyParameterName = fileHostingKeyParameter.Name
-	component.PrivateKeyId = pulumi.StringOutput(publicKey.ID())
-	component.Url = args.Domain.ToStringOutput()
+	return keyGroup, keys, nil
+}
 
-	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{
-		"url":                     component.Url,
-		"privateKeyParameterName": component.PrivateKeyParameterName,
-		"privateKeyId":            component.PrivateKeyId,
-	}); err != nil {
+// signingKeyParameterArns returns the ARNs of the SSM parameters holding the private keys.
+func signingKeyParameterArns(keys []*signingKey) []pulumi.StringInput {
+	arns := []pulumi.StringInput{}
+	for _, key := range keys {
+		arns = append(arns, key.parameter.Arn)
+	}
+	return arns
+}
+
+// newFileHostingSigningKey creates the signing key of the given generation.
+func newFileHostingSigningKey(ctx *pulumi.Context, component pulumi.Resource, name string, generation int, legacy bool) (*signingKey, error) {
+	if legacy && generation == 1 {
+		return newSigningKey(ctx, name,
+			fileHostingChildOptions(component, "gotiacFileHostingPrivateRsaKey"),
+			fileHostingChildOptions(component, "gotiacFileHostingPublicKey"),
+			fileHostingChildOptions(component, "gotiacFileHostingPrivateKey"))
+	}
+	keyName := name
+	if generation > 1 {
+		keyName = fmt.Sprintf("%s-%d", name, generation)
+	}
+	opts := []pulumi.ResourceOption{pulumi.Parent(component)}
+	return newSigningKey(ctx, keyName, opts, opts, opts)
+}
+
+// newSigningKey generates an RSA key pair, registers its public key with CloudFront and stores
+// the private key in an SSM SecureString parameter.
+func newSigningKey(ctx *pulumi.Context, name string,
+	privateKeyOpts, publicKeyOpts, parameterOpts []pulumi.ResourceOption) (*signingKey, error) {
+	// Generate RSA Public/Private Key Pair for CloudFront Trusted Key Groups using tls package
+	privateRsaKey, err := tls.NewPrivateKey(ctx, name+"-private-key", &tls.PrivateKeyArgs{
+		RsaBits:   pulumi.Int(2048),
+		Algorithm: pulumi.String("RSA"),
+	}, privateKeyOpts...)
+	if err != nil {
 		return nil, err
 	}
+	publicRsaKey := tls.GetPublicKeyOutput(ctx, tls.GetPublicKeyOutputArgs{
+		PrivateKeyPem: privateRsaKey.PrivateKeyPem,
+	})
 
-	return component, nil
+	// Create a public key for the CloudFront distribution
+	publicKey, err := cloudfront.NewPublicKey(ctx, name+"-public-key", &cloudfront.PublicKeyArgs{
+		EncodedKey: publicRsaKey.PublicKeyPem(),
+	}, publicKeyOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create an SSM parameter for the private key
+	parameter, err := ssm.NewParameter(ctx, name+"-private-key-parameter", &ssm.ParameterArgs{
+		Type:  pulumi.String("SecureString"),
+		Value: privateRsaKey.PrivateKeyPem,
+	}, parameterOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signingKey{publicKey: publicKey, parameter: parameter}, nil
+}
+
+// readMethods returns the HTTP methods of a cache behavior that only serves files.
+func readMethods() pulumi.StringArray {
+	return pulumi.StringArray{
+		pulumi.String("GET"),
+		pulumi.String("HEAD"),
+		pulumi.String("OPTIONS"),
+	}
+}
+
+// allMethods returns the HTTP methods of a cache behavior that also accepts uploads.
+func allMethods() pulumi.StringArray {
+	return pulumi.StringArray{
+		pulumi.String("GET"),
+		pulumi.String("PUT"),
+		pulumi.String("POST"),
+		pulumi.String("PATCH"),
+		pulumi.String("DELETE"),
+		pulumi.String("HEAD"),
+		pulumi.String("OPTIONS"),
+	}
+}
+
+// cloudFrontBucketStatement returns a bucket policy statement granting the distribution the
+// actions on the resource.
+func cloudFrontBucketStatement(sid string, actions []interface{}, resource pulumi.StringOutput, distributionArn pulumi.StringOutput) map[string]interface{} {
+	return map[string]interface{}{
+		"Sid":    sid,
+		"Effect": "Allow",
+		"Principal": map[string]interface{}{
+			"Service": "cloudfront.amazonaws.com",
+		},
+		"Action": actions,
+		"Resource": []interface{}{
+			resource, // policy refers to bucket name explicitly
+		},
+		"Condition": map[string]interface{}{
+			"StringEquals": map[string]interface{}{
+				"AWS:SourceArn": distributionArn,
+			},
+		},
+	}
+}
+
+// lookUpBucketRegionalDomainName looks up the regional domain name of an existing bucket and
+// checks that the bucket is in the given region.
+func lookUpBucketRegionalDomainName(ctx *pulumi.Context, bucketName, region pulumi.StringInput,
+	opts ...pulumi.InvokeOption) pulumi.StringOutput {
+	return pulumi.All(bucketName, region).ApplyT(func(all []interface{}) (string, error) {
+		name, region := all[0].(string), all[1].(string)
+		bucket, err := s3.LookupBucket(ctx, &s3.LookupBucketArgs{
+			Bucket: name,
+		}, opts...)
+		if err != nil {
+			return "", err
+		}
+		if err := validateBucketRegion(bucket, region); err != nil {
+			return "", err
+		}
+		return bucket.BucketRegionalDomainName, nil
+	}).(pulumi.StringOutput)
+}
+
+// validateBucketRegion checks that the bucket is in the expected region and that its regional
+// domain name points to that region, so CloudFront does not reach the bucket through a redirect.
+func validateBucketRegion(bucket *s3.LookupBucketResult, region string) error {
+	if bucket.Region != region {
+		return fmt.Errorf("bucket %s is in region %s, not in %s, set bucketRegion to the region of the bucket",
+			bucket.Bucket, bucket.Region, region)
+	}
+	regionalDomainName := fmt.Sprintf("%s.s3.%s.", bucket.Bucket, region)
+	// Buckets in us-east-1 may report the global endpoint as their regional domain name.
+	globalDomainName := bucket.Bucket + ".s3.amazonaws.com"
+	if !strings.HasPrefix(bucket.BucketRegionalDomainName, regionalDomainName) &&
+		!(region == "us-east-1" && bucket.BucketRegionalDomainName == globalDomainName) {
+		return fmt.Errorf("the regional domain name %s of bucket %s does not match its region %s",
+			bucket.BucketRegionalDomainName, bucket.Bucket, region)
+	}
+	return nil
+}
+
+// resolveHostedZone returns the given hosted zone ID or, if none is given, looks up the hosted zone
+// for the domain.
+func resolveHostedZone(ctx *pulumi.Context, domain pulumi.StringInput, hostedZoneId *pulumi.StringInput) pulumi.StringInput {
+	if hostedZoneId != nil {
+		return *hostedZoneId
+	}
+	return lookUpHostedZone(ctx, domain)
 }
 
 func lookUpHostedZone(ctx *pulumi.Context, domain pulumi.StringInput) pulumi.StringOutput {
@@ -372,7 +1263,12 @@ func lookUpHostedZone(ctx *pulumi.Context, domain pulumi.StringInput) pulumi.Str
 				Name: &parentDomain,
 			})
 			if err != nil {
-				continue
+				// Only keep walking up when there is no zone for this parent domain, any other
+				// error (e.g. missing permissions) would otherwise be reported as a missing zone.
+				if strings.Contains(err.Error(), "no matching") {
+					continue
+				}
+				return "", err
 			}
 			if hostedZone != nil {
 				return hostedZone.Id, nil
@@ -381,3 +1277,72 @@ func lookUpHostedZone(ctx *pulumi.Context, domain pulumi.StringInput) pulumi.Str
 		return "", errors.New("no hosted zone found for domain " + _domain)
 	}).(pulumi.StringOutput)
 }
+
+// certificateValidationOption returns the domain validation option of the certificate for the
+// given domain.
+func certificateValidationOption(certificate *acm.Certificate, domain pulumi.StringInput) acm.CertificateDomainValidationOptionOutput {
+	return pulumi.All(certificate.DomainValidationOptions, domain).ApplyT(func(all []interface{}) (acm.CertificateDomainValidationOption, error) {
+		options, domain := all[0].([]acm.CertificateDomainValidationOption), all[1].(string)
+		for _, option := range options {
+			if option.DomainName != nil && *option.DomainName == domain {
+				return option, nil
+			}
+		}
+		return acm.CertificateDomainValidationOption{}, errors.New("no domain validation option found for domain " + domain)
+	}).(acm.CertificateDomainValidationOptionOutput)
+}
+
+// newDistributionAliasRecords creates the alias records pointing the domain to the distribution:
+// an A record and, if IPv6 is enabled, an AAAA record. A legacy name is given for the A record of
+// the domain, which used to be created at the top level of the stack.
+func newDistributionAliasRecords(ctx *pulumi.Context, component pulumi.Resource, name, legacyName string,
+	domain pulumi.StringInput, hostedZoneId pulumi.StringInput, distribution *cloudfront.Distribution, ipv6Enabled bool) error {
+	recordTypes := []string{"A"}
+	if ipv6Enabled {
+		recordTypes = append(recordTypes, "AAAA")
+	}
+	for _, recordType := range recordTypes {
+		recordOpts := []pulumi.ResourceOption{pulumi.Parent(component), pulumi.DependsOn([]pulumi.Resource{distribution})}
+		if recordType == "A" && legacyName != "" {
+			recordOpts = fileHostingChildOptions(component, legacyName, pulumi.DependsOn([]pulumi.Resource{distribution}))
+		}
+		if _, err := route53.NewRecord(ctx, name+"-"+strings.ToLower(recordType), &route53.RecordArgs{
+			Name:   domain,
+			Type:   pulumi.String(recordType),
+			ZoneId: hostedZoneId,
+			Aliases: route53.RecordAliasArray{
+				&route53.RecordAliasArgs{
+					Name:                 distribution.DomainName,
+					ZoneId:               distribution.HostedZoneId,
+					EvaluateTargetHealth: pulumi.Bool(true),
+				},
+			},
+		}, recordOpts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// certificateValidationRecords converts the domain validation options of a certificate to the DNS
+// records that have to be created to validate it. Domains sharing a validation record, like a
+// wildcard and its apex, only yield the record once.
+func certificateValidationRecords(options []acm.CertificateDomainValidationOption) []map[string]string {
+	records := []map[string]string{}
+	seen := map[string]bool{}
+	for _, option := range options {
+		if option.ResourceRecordName == nil || option.ResourceRecordType == nil || option.ResourceRecordValue == nil {
+			continue
+		}
+		if seen[*option.ResourceRecordName] {
+			continue
+		}
+		seen[*option.ResourceRecordName] = true
+		records = append(records, map[string]string{
+			"name":  *option.ResourceRecordName,
+			"type":  *option.ResourceRecordType,
+			"value": *option.ResourceRecordValue,
+		})
+	}
+	return records
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/kms"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/s3"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The server side encryption modes of a FileHosting bucket.
+const (
+	bucketEncryptionSseS3  = "SSE-S3"
+	bucketEncryptionSseKms = "SSE-KMS"
+)
+
+// The ways a FileHosting writes the policy of its bucket.
+const (
+	bucketPolicyModeReplace = "replace"
+	bucketPolicyModeMerge   = "merge"
+)
+
+// The settings of the bucket a FileHosting component resource creates when no bucket name is
+// given.
+type FileHostingBucketArgs struct {
+	// Whether versioning is enabled. Defaults to false.
+	Versioning *bool `pulumi:"versioning"`
+	// The server side encryption of the bucket: SSE-S3 or SSE-KMS. Defaults to SSE-S3.
+	Encryption *string `pulumi:"encryption"`
+	// The ARN of the KMS key used with SSE-KMS. Its key policy has to allow CloudFront to use it.
+	// If not provided, a key is generated.
+	KmsKeyArn *pulumi.StringInput `pulumi:"kmsKeyArn"`
+	// The lifecycle rules of the bucket.
+	LifecycleRules []FileHostingLifecycleRuleArgs `pulumi:"lifecycleRules"`
+	// The number of days after which incomplete multipart uploads are aborted. Defaults to 7, 0
+	// disables the rule.
+	AbortIncompleteMultipartUploadDays *int `pulumi:"abortIncompleteMultipartUploadDays"`
+	// Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+	ForceDestroy *bool `pulumi:"forceDestroy"`
+	// Whether the bucket is protected from being deleted by Pulumi. Defaults to false.
+	Protect *bool `pulumi:"protect"`
+	// Whether the bucket is kept in AWS when it is deleted from the stack. Defaults to false.
+	RetainOnDelete *bool `pulumi:"retainOnDelete"`
+}
+
+// A lifecycle rule of the bucket of a FileHosting component resource.
+type FileHostingLifecycleRuleArgs struct {
+	// The key prefix the rule applies to. If not provided, the rule applies to all objects.
+	Prefix *pulumi.StringInput `pulumi:"prefix"`
+	// The number of days after which objects expire.
+	ExpirationDays *pulumi.IntInput `pulumi:"expirationDays"`
+	// The number of days after which noncurrent object versions expire.
+	NoncurrentVersionExpirationDays *pulumi.IntInput `pulumi:"noncurrentVersionExpirationDays"`
+	// The transitions of objects to other storage classes.
+	Transitions []FileHostingLifecycleTransitionArgs `pulumi:"transitions"`
+}
+
+// A transition of objects to another storage class.
+type FileHostingLifecycleTransitionArgs struct {
+	// The number of days after which objects are transitioned.
+	Days pulumi.IntInput `pulumi:"days"`
+	// The storage class objects are transitioned to, e.g. STANDARD_IA or GLACIER_IR.
+	StorageClass pulumi.StringInput `pulumi:"storageClass"`
+}
+
+// validate checks the bucket settings for combinations that cannot be applied.
+func (b *FileHostingBucketArgs) validate() error {
+	encryption := bucketEncryptionSseS3
+	if b.Encryption != nil {
+		encryption = *b.Encryption
+	}
+	switch encryption {
+	case bucketEncryptionSseS3:
+		if b.KmsKeyArn != nil {
+			return fmt.Errorf("bucketOptions.kmsKeyArn requires the %s encryption", bucketEncryptionSseKms)
+		}
+	case bucketEncryptionSseKms:
+	default:
+		return fmt.Errorf("bucketOptions.encryption must be one of %s or %s, got %q",
+			bucketEncryptionSseS3, bucketEncryptionSseKms, encryption)
+	}
+	if b.AbortIncompleteMultipartUploadDays != nil && *b.AbortIncompleteMultipartUploadDays < 0 {
+		return fmt.Errorf("bucketOptions.abortIncompleteMultipartUploadDays must not be negative, got %d",
+			*b.AbortIncompleteMultipartUploadDays)
+	}
+	return nil
+}
+
+// fileHostingBucket is the bucket a FileHosting creates, together with its encryption key.
+type fileHostingBucket struct {
+	bucket *s3.Bucket
+	// The ARN of the KMS key the bucket is encrypted with, nil with SSE-S3.
+	kmsKeyArn pulumi.StringInput
+	// The generated KMS key, whose key policy is attached once the distribution exists.
+	kmsKey *kms.Key
+	// The versioning of the bucket, nil if versioning is disabled.
+	versioning *s3.BucketVersioningV2
+}
+
+// newFileHostingBucket creates the bucket of a FileHosting with the given settings.
+func newFileHostingBucket(ctx *pulumi.Context, component pulumi.Resource, name string,
+	options *FileHostingBucketArgs, opts ...pulumi.ResourceOption) (*fileHostingBucket, error) {
+	if options == nil {
+		options = &FileHostingBucketArgs{}
+	}
+
+	bucketArgs := &s3.BucketArgs{}
+	if options.ForceDestroy != nil {
+		bucketArgs.ForceDestroy = pulumi.Bool(*options.ForceDestroy)
+	}
+	bucketOpts := append([]pulumi.ResourceOption{}, opts...)
+	if options.Protect != nil {
+		bucketOpts = append(bucketOpts, pulumi.Protect(*options.Protect))
+	}
+	if options.RetainOnDelete != nil {
+		bucketOpts = append(bucketOpts, pulumi.RetainOnDelete(*options.RetainOnDelete))
+	}
+	// Create an S3 bucket to host files for the FileHosting service
+	bucket, err := s3.NewBucket(ctx, name+"-bucket", bucketArgs,
+		fileHostingChildOptions(component, "gotiacFileHosting", bucketOpts...)...)
+	if err != nil {
+		return nil, err
+	}
+	result := &fileHostingBucket{bucket: bucket}
+
+	if options.Versioning != nil && *options.Versioning {
+		versioning, err := s3.NewBucketVersioningV2(ctx, name+"-bucket-versioning", &s3.BucketVersioningV2Args{
+			Bucket: bucket.ID(),
+			VersioningConfiguration: &s3.BucketVersioningV2VersioningConfigurationArgs{
+				Status: pulumi.String("Enabled"),
+			},
+		}, pulumi.Parent(bucket))
+		if err != nil {
+			return nil, err
+		}
+		result.versioning = versioning
+	}
+
+	// Encrypt the files at rest, either with S3 managed keys or with a KMS key.
+	encryptionByDefault := &s3.BucketServerSideEncryptionConfigurationV2RuleApplyServerSideEncryptionByDefaultArgs{
+		SseAlgorithm: pulumi.String("AES256"),
+	}
+	if options.Encryption != nil && *options.Encryption == bucketEncryptionSseKms {
+		if options.KmsKeyArn != nil {
+			result.kmsKeyArn = *options.KmsKeyArn
+		} else {
+			kmsKey, err := kms.NewKey(ctx, name+"-bucket-key", &kms.KeyArgs{
+				Description:       pulumi.String("Encryption key for FileHosting"),
+				EnableKeyRotation: pulumi.Bool(true),
+			}, append([]pulumi.ResourceOption{pulumi.Parent(component)}, opts...)...)
+			if err != nil {
+				return nil, err
+			}
+			result.kmsKey = kmsKey
+			result.kmsKeyArn = kmsKey.Arn
+		}
+		encryptionByDefault = &s3.BucketServerSideEncryptionConfigurationV2RuleApplyServerSideEncryptionByDefaultArgs{
+			SseAlgorithm:   pulumi.String("aws:kms"),
+			KmsMasterKeyId: result.kmsKeyArn,
+		}
+	}
+	if _, err := s3.NewBucketServerSideEncryptionConfigurationV2(ctx, name+"-bucket-encryption", &s3.BucketServerSideEncryptionConfigurationV2Args{
+		Bucket: bucket.ID(),
+		Rules: s3.BucketServerSideEncryptionConfigurationV2RuleArray{
+			&s3.BucketServerSideEncryptionConfigurationV2RuleArgs{
+				ApplyServerSideEncryptionByDefault: encryptionByDefault,
+				BucketKeyEnabled:                   pulumi.Bool(result.kmsKeyArn != nil),
+			},
+		},
+	}, pulumi.Parent(bucket)); err != nil {
+		return nil, err
+	}
+
+	// Apply the lifecycle rules and clean up incomplete multipart uploads.
+	rules := s3.BucketLifecycleConfigurationV2RuleArray{}
+	for i, rule := range options.LifecycleRules {
+		filter := &s3.BucketLifecycleConfigurationV2RuleFilterArgs{}
+		if rule.Prefix != nil {
+			filter.Prefix = *rule.Prefix
+		}
+		ruleArgs := &s3.BucketLifecycleConfigurationV2RuleArgs{
+			Id:     pulumi.Sprintf("rule-%d", i),
+			Status: pulumi.String("Enabled"),
+			Filter: filter,
+		}
+		if rule.ExpirationDays != nil {
+			ruleArgs.Expiration = &s3.BucketLifecycleConfigurationV2RuleExpirationArgs{
+				Days: *rule.ExpirationDays,
+			}
+		}
+		if rule.NoncurrentVersionExpirationDays != nil {
+			ruleArgs.NoncurrentVersionExpiration = &s3.BucketLifecycleConfigurationV2RuleNoncurrentVersionExpirationArgs{
+				NoncurrentDays: *rule.NoncurrentVersionExpirationDays,
+			}
+		}
+		transitions := s3.BucketLifecycleConfigurationV2RuleTransitionArray{}
+		for _, transition := range rule.Transitions {
+			transitions = append(transitions, &s3.BucketLifecycleConfigurationV2RuleTransitionArgs{
+				Days:         transition.Days,
+				StorageClass: transition.StorageClass,
+			})
+		}
+		if len(transitions) > 0 {
+			ruleArgs.Transitions = transitions
+		}
+		rules = append(rules, ruleArgs)
+	}
+	abortIncompleteMultipartUploadDays := 7
+	if options.AbortIncompleteMultipartUploadDays != nil {
+		abortIncompleteMultipartUploadDays = *options.AbortIncompleteMultipartUploadDays
+	}
+	if abortIncompleteMultipartUploadDays > 0 {
+		rules = append(rules, &s3.BucketLifecycleConfigurationV2RuleArgs{
+			Id:     pulumi.String("abort-incomplete-multipart-upload"),
+			Status: pulumi.String("Enabled"),
+			Filter: &s3.BucketLifecycleConfigurationV2RuleFilterArgs{},
+			AbortIncompleteMultipartUpload: &s3.BucketLifecycleConfigurationV2RuleAbortIncompleteMultipartUploadArgs{
+				DaysAfterInitiation: pulumi.Int(abortIncompleteMultipartUploadDays),
+			},
+		})
+	}
+	if len(rules) > 0 {
+		if _, err := s3.NewBucketLifecycleConfigurationV2(ctx, name+"-bucket-lifecycle", &s3.BucketLifecycleConfigurationV2Args{
+			Bucket: bucket.ID(),
+			Rules:  rules,
+		}, pulumi.Parent(bucket)); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// newFileHostingKeyPolicy attaches the key policy to a generated bucket key, which allows the
+// account to manage the key and the distribution to encrypt and decrypt files with it.
+func newFileHostingKeyPolicy(ctx *pulumi.Context, name string, kmsKey *kms.Key,
+	accountId string, distributionArn pulumi.StringOutput) error {
+	_, err := kms.NewKeyPolicy(ctx, name+"-bucket-key-policy", &kms.KeyPolicyArgs{
+		KeyId: kmsKey.ID(),
+		Policy: pulumi.JSONMarshal(map[string]interface{}{
+			"Version": "2012-10-17",
+			"Statement": []map[string]interface{}{
+				{
+					"Sid":    "AllowAccount",
+					"Effect": "Allow",
+					"Principal": map[string]interface{}{
+						"AWS": fmt.Sprintf("arn:aws:iam::%s:root", accountId),
+					},
+					"Action":   "kms:*",
+					"Resource": "*",
+				},
+				{
+					"Sid":    "AllowCloudFront",
+					"Effect": "Allow",
+					"Principal": map[string]interface{}{
+						"Service": "cloudfront.amazonaws.com",
+					},
+					"Action": []interface{}{
+						"kms:Decrypt",
+						"kms:Encrypt",
+						"kms:GenerateDataKey*",
+					},
+					"Resource": "*",
+					"Condition": map[string]interface{}{
+						"StringEquals": map[string]interface{}{
+							"AWS:SourceArn": distributionArn,
+						},
+					},
+				},
+			},
+		}),
+	}, pulumi.Parent(kmsKey))
+	return err
+}
+
+// The suffixes of the Sids of the bucket policy statements a FileHosting writes.
+var bucketPolicySidSuffixes = []string{"ReadWrite", "Read", "Upload", "FailoverRead"}
+
+// bucketPolicySidPrefix returns the prefix of the Sids of the bucket policy statements of the
+// FileHosting with the given name. Sids may only contain alphanumeric characters, so the
+// alphanumeric characters of the name are followed by a hash of the full name to keep names like
+// media-2 and media2 apart.
+func bucketPolicySidPrefix(name string) string {
+	sid := "GotiacFileHosting"
+	for _, r := range name {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			sid += string(r)
+		}
+	}
+	nameHash := sha256.Sum256([]byte(name))
+	return sid + hex.EncodeToString(nameHash[:])[:8]
+}
+
+// mergeBucketPolicy returns the current policy of the bucket with the statements of the component
+// replaced by the given ones. Statements of the component are recognized by the Sids it can write,
+// so the statements of components whose Sids merely share the prefix are kept.
+func mergeBucketPolicy(ctx *pulumi.Context, bucketName pulumi.StringInput, sidPrefix string,
+	statements []map[string]interface{}, opts ...pulumi.InvokeOption) pulumi.StringOutput {
+	componentSids := map[string]bool{}
+	for _, suffix := range bucketPolicySidSuffixes {
+		componentSids[sidPrefix+suffix] = true
+	}
+	return pulumi.All(bucketName, pulumi.JSONMarshal(statements)).ApplyT(func(all []interface{}) (string, error) {
+		bucket, statementsJson := all[0].(string), all[1].(string)
+
+		var componentStatements []interface{}
+		if err := json.Unmarshal([]byte(statementsJson), &componentStatements); err != nil {
+			return "", err
+		}
+
+		policy := map[string]interface{}{
+			"Version": "2012-10-17",
+		}
+		existing, err := s3.LookupBucketPolicy(ctx, &s3.LookupBucketPolicyArgs{
+			Bucket: bucket,
+		}, opts...)
+		if err != nil && !strings.Contains(err.Error(), "NoSuchBucketPolicy") {
+			return "", err
+		}
+		if err == nil && existing.Policy != "" {
+			if err := json.Unmarshal([]byte(existing.Policy), &policy); err != nil {
+				return "", fmt.Errorf("parsing the policy of bucket %s: %w", bucket, err)
+			}
+		}
+
+		// A policy with a single statement may hold it as an object instead of a list.
+		var existingStatements []interface{}
+		switch statement := policy["Statement"].(type) {
+		case []interface{}:
+			existingStatements = statement
+		case map[string]interface{}:
+			existingStatements = []interface{}{statement}
+		}
+		merged := []interface{}{}
+		for _, statement := range existingStatements {
+			if statement, ok := statement.(map[string]interface{}); ok {
+				if sid, ok := statement["Sid"].(string); ok && componentSids[sid] {
+					continue
+				}
+			}
+			merged = append(merged, statement)
+		}
+		policy["Statement"] = append(merged, componentStatements...)
+
+		result, err := json.Marshal(policy)
+		if err != nil {
+			return "", err
+		}
+		return string(result), nil
+	}).(pulumi.StringOutput)
+}
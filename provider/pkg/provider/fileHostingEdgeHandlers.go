@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/cloudfront"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The edge handlers of a cache behavior of a FileHosting component resource. Each event type takes
+// either a CloudFront Function or a Lambda@Edge function.
+type FileHostingEdgeHandlersArgs struct {
+	// The code of a CloudFront Function run on viewer requests, e.g. to rewrite paths or check
+	// tokens. The function is created and published with the cloudfront-js-2.0 runtime.
+	ViewerRequestFunction *pulumi.StringInput `pulumi:"viewerRequestFunction"`
+	// The code of a CloudFront Function run on viewer responses, e.g. to set headers.
+	ViewerResponseFunction *pulumi.StringInput `pulumi:"viewerResponseFunction"`
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer requests.
+	ViewerRequestLambdaArn *pulumi.StringInput `pulumi:"viewerRequestLambdaArn"`
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer responses.
+	ViewerResponseLambdaArn *pulumi.StringInput `pulumi:"viewerResponseLambdaArn"`
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin requests.
+	OriginRequestLambdaArn *pulumi.StringInput `pulumi:"originRequestLambdaArn"`
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin responses,
+	// e.g. to set the Content-Disposition header before the response is cached.
+	OriginResponseLambdaArn *pulumi.StringInput `pulumi:"originResponseLambdaArn"`
+}
+
+// validate checks that no event type has both a CloudFront Function and a Lambda@Edge function.
+// The path is the name of the argument in error messages.
+func (h *FileHostingEdgeHandlersArgs) validate(path string) error {
+	if h == nil {
+		return nil
+	}
+	if h.ViewerRequestFunction != nil && h.ViewerRequestLambdaArn != nil {
+		return fmt.Errorf("only one of %s.viewerRequestFunction and %s.viewerRequestLambdaArn can be set", path, path)
+	}
+	if h.ViewerResponseFunction != nil && h.ViewerResponseLambdaArn != nil {
+		return fmt.Errorf("only one of %s.viewerResponseFunction and %s.viewerResponseLambdaArn can be set", path, path)
+	}
+	return nil
+}
+
+// edgeHandler is a function associated with an event type of a cache behavior.
+type edgeHandler struct {
+	eventType string
+	arn       pulumi.StringInput
+}
+
+// edgeHandlers are the CloudFront Functions and Lambda@Edge functions of a cache behavior.
+type edgeHandlers struct {
+	functions []edgeHandler
+	lambdas   []edgeHandler
+}
+
+// newEdgeHandlers creates and publishes the CloudFront Functions of a cache behavior and collects
+// them with its Lambda@Edge functions.
+func newEdgeHandlers(ctx *pulumi.Context, component pulumi.Resource, name string,
+	args *FileHostingEdgeHandlersArgs) (*edgeHandlers, error) {
+	handlers := &edgeHandlers{}
+	if args == nil {
+		return handlers, nil
+	}
+
+	for _, function := range []struct {
+		eventType string
+		code      *pulumi.StringInput
+	}{
+		{"viewer-request", args.ViewerRequestFunction},
+		{"viewer-response", args.ViewerResponseFunction},
+	} {
+		if function.code == nil {
+			continue
+		}
+		cloudFrontFunction, err := cloudfront.NewFunction(ctx, name+"-"+function.eventType+"-function", &cloudfront.FunctionArgs{
+			Runtime: pulumi.String("cloudfront-js-2.0"),
+			Code:    *function.code,
+			Publish: pulumi.Bool(true),
+		}, pulumi.Parent(component))
+		if err != nil {
+			return nil, err
+		}
+		handlers.functions = append(handlers.functions, edgeHandler{eventType: function.eventType, arn: cloudFrontFunction.Arn})
+	}
+
+	for _, lambda := range []struct {
+		eventType string
+		arn       *pulumi.StringInput
+	}{
+		{"viewer-request", args.ViewerRequestLambdaArn},
+		{"viewer-response", args.ViewerResponseLambdaArn},
+		{"origin-request", args.OriginRequestLambdaArn},
+		{"origin-response", args.OriginResponseLambdaArn},
+	} {
+		if lambda.arn != nil {
+			handlers.lambdas = append(handlers.lambdas, edgeHandler{eventType: lambda.eventType, arn: *lambda.arn})
+		}
+	}
+
+	return handlers, nil
+}
+
+// defaultCacheBehaviorFunctionAssociations returns the CloudFront Function associations of the
+// default cache behavior.
+func (h *edgeHandlers) defaultCacheBehaviorFunctionAssociations() cloudfront.DistributionDefaultCacheBehaviorFunctionAssociationArray {
+	var associations cloudfront.DistributionDefaultCacheBehaviorFunctionAssociationArray
+	for _, function := range h.functions {
+		associations = append(associations, &cloudfront.DistributionDefaultCacheBehaviorFunctionAssociationArgs{
+			EventType:   pulumi.String(function.eventType),
+			FunctionArn: function.arn,
+		})
+	}
+	return associations
+}
+
+// defaultCacheBehaviorLambdaAssociations returns the Lambda@Edge associations of the default
+// cache behavior.
+func (h *edgeHandlers) defaultCacheBehaviorLambdaAssociations() cloudfront.DistributionDefaultCacheBehaviorLambdaFunctionAssociationArray {
+	var associations cloudfront.DistributionDefaultCacheBehaviorLambdaFunctionAssociationArray
+	for _, lambda := range h.lambdas {
+		associations = append(associations, &cloudfront.DistributionDefaultCacheBehaviorLambdaFunctionAssociationArgs{
+			EventType: pulumi.String(lambda.eventType),
+			LambdaArn: lambda.arn,
+		})
+	}
+	return associations
+}
+
+// orderedCacheBehaviorFunctionAssociations returns the CloudFront Function associations of a cache
+// behavior for a path pattern.
+func (h *edgeHandlers) orderedCacheBehaviorFunctionAssociations() cloudfront.DistributionOrderedCacheBehaviorFunctionAssociationArray {
+	var associations cloudfront.DistributionOrderedCacheBehaviorFunctionAssociationArray
+	for _, function := range h.functions {
+		associations = append(associations, &cloudfront.DistributionOrderedCacheBehaviorFunctionAssociationArgs{
+			EventType:   pulumi.String(function.eventType),
+			FunctionArn: function.arn,
+		})
+	}
+	return associations
+}
+
+// orderedCacheBehaviorLambdaAssociations returns the Lambda@Edge associations of a cache behavior
+// for a path pattern.
+func (h *edgeHandlers) orderedCacheBehaviorLambdaAssociations() cloudfront.DistributionOrderedCacheBehaviorLambdaFunctionAssociationArray {
+	var associations cloudfront.DistributionOrderedCacheBehaviorLambdaFunctionAssociationArray
+	for _, lambda := range h.lambdas {
+		associations = append(associations, &cloudfront.DistributionOrderedCacheBehaviorLambdaFunctionAssociationArgs{
+			EventType: pulumi.String(lambda.eventType),
+			LambdaArn: lambda.arn,
+		})
+	}
+	return associations
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/iam"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// iamPolicies are the IAM policy documents of the roles that use a FileHosting, together with the
+// ARNs of the managed policies created for them.
+type iamPolicies struct {
+	signer       pulumi.StringOutput
+	bucketReader pulumi.StringOutput
+	bucketWriter pulumi.StringOutput
+
+	// The ARNs of the managed policies, empty unless they are created.
+	signerArn       pulumi.StringOutput
+	bucketReaderArn pulumi.StringOutput
+	bucketWriterArn pulumi.StringOutput
+}
+
+// newFileHostingIamPolicies returns the policy documents of the signer, bucket reader and bucket
+// writer roles, scoped to the private key parameters, the bucket and its KMS key. With managed set,
+// a managed policy is created per document.
+func newFileHostingIamPolicies(ctx *pulumi.Context, component pulumi.Resource, name string,
+	keyParameterArns []pulumi.StringInput, bucketName pulumi.StringInput, bucketKmsKeyArn pulumi.StringInput,
+	managed bool) (*iamPolicies, error) {
+	// SSM decrypts SecureString parameters with the parameter ARN as the PARAMETER_ARN encryption
+	// context, which scopes the KMS permission to the parameters without knowing their key.
+	parameterArns := pulumi.StringArray{}
+	for _, arn := range keyParameterArns {
+		parameterArns = append(parameterArns, arn)
+	}
+	signerStatements := []map[string]interface{}{
+		{
+			"Effect":   "Allow",
+			"Action":   []interface{}{"ssm:GetParameter"},
+			"Resource": parameterArns,
+		},
+		{
+			"Effect":   "Allow",
+			"Action":   []interface{}{"kms:Decrypt"},
+			"Resource": "*",
+			"Condition": map[string]interface{}{
+				"StringEquals": map[string]interface{}{
+					"kms:EncryptionContext:PARAMETER_ARN": parameterArns,
+				},
+			},
+		},
+	}
+
+	bucketArn := pulumi.Sprintf("arn:aws:s3:::%s", bucketName)
+	objectsArn := pulumi.Sprintf("arn:aws:s3:::%s/*", bucketName)
+	readerStatements := []map[string]interface{}{
+		{
+			"Effect":   "Allow",
+			"Action":   []interface{}{"s3:ListBucket"},
+			"Resource": []interface{}{bucketArn},
+		},
+		{
+			"Effect":   "Allow",
+			"Action":   []interface{}{"s3:GetObject"},
+			"Resource": []interface{}{objectsArn},
+		},
+	}
+	writerStatements := []map[string]interface{}{
+		{
+			"Effect":   "Allow",
+			"Action":   []interface{}{"s3:ListBucket", "s3:ListBucketMultipartUploads"},
+			"Resource": []interface{}{bucketArn},
+		},
+		{
+			"Effect":   "Allow",
+			"Action":   []interface{}{"s3:GetObject", "s3:PutObject", "s3:DeleteObject", "s3:AbortMultipartUpload"},
+			"Resource": []interface{}{objectsArn},
+		},
+	}
+	if bucketKmsKeyArn != nil {
+		readerStatements = append(readerStatements, map[string]interface{}{
+			"Effect":   "Allow",
+			"Action":   []interface{}{"kms:Decrypt"},
+			"Resource": []interface{}{bucketKmsKeyArn},
+		})
+		writerStatements = append(writerStatements, map[string]interface{}{
+			"Effect":   "Allow",
+			"Action":   []interface{}{"kms:Decrypt", "kms:GenerateDataKey"},
+			"Resource": []interface{}{bucketKmsKeyArn},
+		})
+	}
+
+	result := &iamPolicies{
+		signer:          iamPolicyDocument(signerStatements),
+		bucketReader:    iamPolicyDocument(readerStatements),
+		bucketWriter:    iamPolicyDocument(writerStatements),
+		signerArn:       pulumi.String("").ToStringOutput(),
+		bucketReaderArn: pulumi.String("").ToStringOutput(),
+		bucketWriterArn: pulumi.String("").ToStringOutput(),
+	}
+	if !managed {
+		return result, nil
+	}
+
+	for _, policy := range []struct {
+		suffix      string
+		description string
+		document    pulumi.StringOutput
+		arn         *pulumi.StringOutput
+	}{
+		{"signer", "Signs URLs and cookies for FileHosting " + name, result.signer, &result.signerArn},
+		{"bucket-reader", "Reads the bucket of FileHosting " + name, result.bucketReader, &result.bucketReaderArn},
+		{"bucket-writer", "Reads and writes the bucket of FileHosting " + name, result.bucketWriter, &result.bucketWriterArn},
+	} {
+		managedPolicy, err := iam.NewPolicy(ctx, name+"-"+policy.suffix+"-policy", &iam.PolicyArgs{
+			Description: pulumi.String(policy.description),
+			Policy:      policy.document,
+		}, pulumi.Parent(component))
+		if err != nil {
+			return nil, err
+		}
+		*policy.arn = managedPolicy.Arn
+	}
+
+	return result, nil
+}
+
+// iamPolicyDocument returns the JSON of an IAM policy document with the statements.
+func iamPolicyDocument(statements []map[string]interface{}) pulumi.StringOutput {
+	return pulumi.JSONMarshal(map[string]interface{}{
+		"Version":   "2012-10-17",
+		"Statement": statements,
+	})
+}
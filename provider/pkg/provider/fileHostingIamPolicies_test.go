@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type mocks struct{}
+
+func (mocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	return args.Name + "_id", args.Inputs, nil
+}
+
+func (mocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	return args.Args, nil
+}
+
+type policyDocument struct {
+	Version   string
+	Statement []struct {
+		Effect    string
+		Action    []string
+		Resource  interface{}
+		Condition map[string]map[string][]string
+	}
+}
+
+func TestFileHostingIamPoliciesSigner(t *testing.T) {
+	keyParameterArns := []string{
+		"arn:aws:ssm:eu-central-1:123456789012:parameter/files-private-key-parameter",
+		"arn:aws:ssm:eu-central-1:123456789012:parameter/files-2-private-key-parameter",
+		"arn:aws:ssm:eu-central-1:123456789012:parameter/files-upload-2-private-key-parameter",
+	}
+
+	var document string
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		arns := []pulumi.StringInput{}
+		for _, arn := range keyParameterArns {
+			arns = append(arns, pulumi.String(arn))
+		}
+		policies, err := newFileHostingIamPolicies(ctx, nil, "files", arns, pulumi.String("files-bucket"), nil, false)
+		if err != nil {
+			return err
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		policies.signer.ApplyT(func(signer string) string {
+			document = signer
+			wg.Done()
+			return signer
+		})
+		wg.Wait()
+		return nil
+	}, pulumi.WithMocks("project", "stack", mocks{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var policy policyDocument
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		t.Fatalf("invalid policy document %s: %v", document, err)
+	}
+	if len(policy.Statement) != 2 {
+		t.Fatalf("expected 2 statements, got %s", document)
+	}
+
+	getParameter := policy.Statement[0]
+	if !reflect.DeepEqual(getParameter.Action, []string{"ssm:GetParameter"}) {
+		t.Fatalf("unexpected actions %v", getParameter.Action)
+	}
+	resources, _ := json.Marshal(getParameter.Resource)
+	expectedResources, _ := json.Marshal(keyParameterArns)
+	if string(resources) != string(expectedResources) {
+		t.Fatalf("expected the parameters %s, got %s", expectedResources, resources)
+	}
+
+	decrypt := policy.Statement[1]
+	if !reflect.DeepEqual(decrypt.Action, []string{"kms:Decrypt"}) {
+		t.Fatalf("unexpected actions %v", decrypt.Action)
+	}
+	expectedCondition := map[string]map[string][]string{
+		"StringEquals": {"kms:EncryptionContext:PARAMETER_ARN": keyParameterArns},
+	}
+	if !reflect.DeepEqual(decrypt.Condition, expectedCondition) {
+		t.Fatalf("expected the condition %v, got %s", expectedCondition, document)
+	}
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	awsconfig "github.com/pulumi/pulumi-aws/sdk/v6/go/aws/config"
+	"github.com/pulumi/pulumi-gotiac/pkg/invalidation"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The set of arguments for the FileHosting invalidate method.
+type FileHostingInvalidateArgs struct {
+	// The paths to invalidate, relative to the file hosting URL. Paths start with / and may end
+	// with a * wildcard.
+	Paths pulumi.StringArrayInput `pulumi:"paths"`
+}
+
+// The result of the FileHosting invalidate method.
+type FileHostingInvalidateResult struct {
+	InvalidationId pulumi.StringOutput `pulumi:"invalidationId"`
+}
+
+// Invalidate invalidates paths of the FileHosting distribution and waits until the invalidation
+// has completed. Every update of the stack creates a new invalidation, previews create none. The
+// invalidation is created with the credentials of the default AWS provider of the stack.
+func (c *FileHosting) Invalidate(ctx *pulumi.Context, args *FileHostingInvalidateArgs) (*FileHostingInvalidateResult, error) {
+	if args.Paths == nil {
+		return nil, errors.New("paths is required")
+	}
+
+	invalidationId := pulumi.All(c.whenDistributionCreated(c.DistributionId), args.Paths).ApplyT(func(all []interface{}) (string, error) {
+		distributionId, paths := all[0].(string), all[1].([]string)
+		if ctx.DryRun() {
+			return "", nil
+		}
+		config, err := invalidationConfig(ctx)
+		if err != nil {
+			return "", err
+		}
+		invalidator, err := invalidation.NewFromConfig(ctx.Context(), config)
+		if err != nil {
+			return "", err
+		}
+		return invalidator.Invalidate(ctx.Context(), distributionId, paths)
+	}).(pulumi.StringOutput)
+
+	return &FileHostingInvalidateResult{InvalidationId: invalidationId}, nil
+}
+
+// invalidationConfig returns the settings of the default AWS provider of the stack, so the
+// invalidation is created with the same credentials as the resources of the stack.
+func invalidationConfig(ctx *pulumi.Context) (invalidation.Config, error) {
+	config := invalidation.Config{
+		Profile:   awsconfig.GetProfile(ctx),
+		AccessKey: awsconfig.GetAccessKey(ctx),
+		SecretKey: awsconfig.GetSecretKey(ctx),
+		Token:     awsconfig.GetToken(ctx),
+	}
+	if assumeRole := awsconfig.GetAssumeRole(ctx); assumeRole != "" {
+		var role struct {
+			RoleArn     string `json:"roleArn"`
+			SessionName string `json:"sessionName"`
+			ExternalId  string `json:"externalId"`
+		}
+		if err := json.Unmarshal([]byte(assumeRole), &role); err != nil {
+			return config, errors.Wrap(err, "parsing aws:assumeRole")
+		}
+		config.RoleArn, config.SessionName, config.ExternalId = role.RoleArn, role.SessionName, role.ExternalId
+	}
+	if endpoints := awsconfig.GetEndpoints(ctx); endpoints != "" {
+		var serviceEndpoints []map[string]string
+		if err := json.Unmarshal([]byte(endpoints), &serviceEndpoints); err != nil {
+			return config, errors.Wrap(err, "parsing aws:endpoints")
+		}
+		for _, serviceEndpoint := range serviceEndpoints {
+			if endpoint := serviceEndpoint["cloudfront"]; endpoint != "" {
+				config.Endpoint = endpoint
+			}
+		}
+	}
+	return config, nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/s3"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The access logging settings of a FileHosting component resource.
+type FileHostingLoggingArgs struct {
+	// The name of an existing bucket to write the logs to. It needs ACLs enabled for CloudFront
+	// standard logs and a bucket policy allowing S3 server access logs. If not provided, a log
+	// bucket is created.
+	BucketName *pulumi.StringInput `pulumi:"bucketName"`
+	// The key prefix of the CloudFront standard logs. Defaults to cloudfront/.
+	CloudFrontPrefix *pulumi.StringInput `pulumi:"cloudFrontPrefix"`
+	// The key prefix of the S3 server access logs. Defaults to s3/.
+	S3Prefix *pulumi.StringInput `pulumi:"s3Prefix"`
+	// Whether CloudFront logs include cookies. Defaults to false.
+	IncludeCookies *pulumi.BoolInput `pulumi:"includeCookies"`
+	// The number of days logs are kept in a created log bucket. Defaults to 90.
+	RetentionDays *pulumi.IntInput `pulumi:"retentionDays"`
+}
+
+// logBucket is the bucket the access logs of a FileHosting are written to.
+type logBucket struct {
+	name       pulumi.StringOutput
+	domainName pulumi.StringOutput
+	// The resources that have to exist before CloudFront can write logs to the bucket.
+	dependencies []pulumi.Resource
+}
+
+// newFileHostingLogBucket returns the given log bucket or creates one that CloudFront standard
+// logs and S3 server access logs can be written to. S3 only delivers server access logs within a
+// region, so the log bucket is created with the provider of the file hosting bucket.
+func newFileHostingLogBucket(ctx *pulumi.Context, component pulumi.Resource, name string,
+	logging *FileHostingLoggingArgs, accountId string, opts ...pulumi.ResourceOption) (*logBucket, error) {
+	if logging.BucketName != nil {
+		bucketName := (*logging.BucketName).ToStringOutput()
+		return &logBucket{
+			name:       bucketName,
+			domainName: pulumi.Sprintf("%s.s3.amazonaws.com", bucketName),
+		}, nil
+	}
+
+	bucket, err := s3.NewBucket(ctx, name+"-log-bucket", &s3.BucketArgs{},
+		append([]pulumi.ResourceOption{pulumi.Parent(component)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// CloudFront standard logs are delivered through the bucket ACL, so ACLs stay enabled.
+	ownershipControls, err := s3.NewBucketOwnershipControls(ctx, name+"-log-bucket-ownership-controls", &s3.BucketOwnershipControlsArgs{
+		Bucket: bucket.ID(),
+		Rule: &s3.BucketOwnershipControlsRuleArgs{
+			ObjectOwnership: pulumi.String("BucketOwnerPreferred"),
+		},
+	}, pulumi.Parent(bucket))
+	if err != nil {
+		return nil, err
+	}
+
+	publicAccessBlock, err := s3.NewBucketPublicAccessBlock(ctx, name+"-log-bucket-public-access-block", &s3.BucketPublicAccessBlockArgs{
+		Bucket:                bucket.ID(),
+		BlockPublicPolicy:     pulumi.Bool(true),
+		BlockPublicAcls:       pulumi.Bool(true),
+		IgnorePublicAcls:      pulumi.Bool(true),
+		RestrictPublicBuckets: pulumi.Bool(true),
+	}, pulumi.Parent(bucket))
+	if err != nil {
+		return nil, err
+	}
+
+	// Expire the logs after the retention period.
+	var retentionDays pulumi.IntInput = pulumi.Int(90)
+	if logging.RetentionDays != nil {
+		retentionDays = *logging.RetentionDays
+	}
+	if _, err := s3.NewBucketLifecycleConfigurationV2(ctx, name+"-log-bucket-lifecycle", &s3.BucketLifecycleConfigurationV2Args{
+		Bucket: bucket.ID(),
+		Rules: s3.BucketLifecycleConfigurationV2RuleArray{
+			&s3.BucketLifecycleConfigurationV2RuleArgs{
+				Id:     pulumi.String("log-retention"),
+				Status: pulumi.String("Enabled"),
+				Filter: &s3.BucketLifecycleConfigurationV2RuleFilterArgs{},
+				Expiration: &s3.BucketLifecycleConfigurationV2RuleExpirationArgs{
+					Days: retentionDays,
+				},
+				AbortIncompleteMultipartUpload: &s3.BucketLifecycleConfigurationV2RuleAbortIncompleteMultipartUploadArgs{
+					DaysAfterInitiation: pulumi.Int(7),
+				},
+			},
+		},
+	}, pulumi.Parent(bucket)); err != nil {
+		return nil, err
+	}
+
+	// Allow S3 to deliver the server access logs of buckets in this account.
+	if _, err := s3.NewBucketPolicy(ctx, name+"-log-bucket-policy", &s3.BucketPolicyArgs{
+		Bucket: bucket.ID(),
+		Policy: pulumi.Any(map[string]interface{}{
+			"Version": "2012-10-17",
+			"Statement": []map[string]interface{}{
+				{
+					"Effect": "Allow",
+					"Principal": map[string]interface{}{
+						"Service": "logging.s3.amazonaws.com",
+					},
+					"Action": []interface{}{
+						"s3:PutObject",
+					},
+					"Resource": []interface{}{
+						pulumi.Sprintf("%s/*", bucket.Arn),
+					},
+					"Condition": map[string]interface{}{
+						"StringEquals": map[string]interface{}{
+							"aws:SourceAccount": accountId,
+						},
+					},
+				},
+			},
+		}),
+	}, pulumi.Parent(bucket), pulumi.DependsOn([]pulumi.Resource{publicAccessBlock})); err != nil {
+		return nil, err
+	}
+
+	return &logBucket{
+		name:         bucket.Bucket,
+		domainName:   bucket.BucketDomainName,
+		dependencies: []pulumi.Resource{ownershipControls},
+	}, nil
+}
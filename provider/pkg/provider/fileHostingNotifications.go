@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"errors"
+
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/lambda"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/s3"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/sns"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/sqs"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The event notification settings of the bucket of a FileHosting component resource. The
+// notification configuration replaces any existing configuration of the bucket. S3 rejects
+// overlapping filters of the same event across destinations, so the events go to a single
+// destination and optionally to EventBridge.
+type FileHostingNotificationsArgs struct {
+	// The S3 events notifications are sent for. Defaults to s3:ObjectCreated:*.
+	Events []string `pulumi:"events"`
+	// The key prefix of the objects notifications are sent for, e.g. uploads/.
+	FilterPrefix *pulumi.StringInput `pulumi:"filterPrefix"`
+	// The key suffix of the objects notifications are sent for, e.g. .jpg.
+	FilterSuffix *pulumi.StringInput `pulumi:"filterSuffix"`
+	// Whether to create an SQS queue the notifications are sent to. Conflicts with the other
+	// destinations.
+	Queue *bool `pulumi:"queue"`
+	// The ARN of an existing SQS queue to send the notifications to. Its policy has to allow S3 to
+	// send messages. Conflicts with the other destinations.
+	QueueArn *pulumi.StringInput `pulumi:"queueArn"`
+	// Whether to create an SNS topic the notifications are published to. Conflicts with the other
+	// destinations.
+	Topic *bool `pulumi:"topic"`
+	// The ARN of an existing SNS topic to publish the notifications to. Its policy has to allow S3
+	// to publish. Conflicts with the other destinations.
+	TopicArn *pulumi.StringInput `pulumi:"topicArn"`
+	// The ARN of a Lambda function in the region of the bucket to invoke. The permission for S3 to
+	// invoke it is created. Conflicts with the other destinations.
+	LambdaFunctionArn *pulumi.StringInput `pulumi:"lambdaFunctionArn"`
+	// Whether all events of the bucket are sent to EventBridge. Defaults to false.
+	EventBridge *bool `pulumi:"eventBridge"`
+}
+
+// validate checks that the notifications have at most one destination besides EventBridge and at
+// least one destination in total.
+func (n *FileHostingNotificationsArgs) validate() error {
+	destinations := 0
+	for _, set := range []bool{
+		n.Queue != nil && *n.Queue,
+		n.QueueArn != nil,
+		n.Topic != nil && *n.Topic,
+		n.TopicArn != nil,
+		n.LambdaFunctionArn != nil,
+	} {
+		if set {
+			destinations++
+		}
+	}
+	if destinations > 1 {
+		return errors.New("only one of notifications.queue, queueArn, topic, topicArn and lambdaFunctionArn can be set")
+	}
+	if destinations == 0 && (n.EventBridge == nil || !*n.EventBridge) {
+		return errors.New("notifications requires a destination or eventBridge")
+	}
+	return nil
+}
+
+// bucketNotifications are the destinations created for the event notifications of the bucket.
+type bucketNotifications struct {
+	// The ARN of the created queue, empty unless a queue is created.
+	queueArn pulumi.StringOutput
+	// The ARN of the created topic, empty unless a topic is created.
+	topicArn pulumi.StringOutput
+}
+
+// newFileHostingNotifications creates the destination of the event notifications of the bucket,
+// allows S3 to deliver to it and configures the notifications. The options carry the provider of
+// the region of the bucket, as the destinations have to be in the same region.
+func newFileHostingNotifications(ctx *pulumi.Context, component pulumi.Resource, name string,
+	notifications *FileHostingNotificationsArgs, bucketName pulumi.StringInput, accountId string,
+	opts ...pulumi.ResourceOption) (*bucketNotifications, error) {
+	result := &bucketNotifications{
+		queueArn: pulumi.String("").ToStringOutput(),
+		topicArn: pulumi.String("").ToStringOutput(),
+	}
+	childOpts := append([]pulumi.ResourceOption{pulumi.Parent(component)}, opts...)
+	bucketArn := pulumi.Sprintf("arn:aws:s3:::%s", bucketName)
+
+	events := pulumi.StringArray{pulumi.String("s3:ObjectCreated:*")}
+	if notifications.Events != nil {
+		events = pulumi.ToStringArray(notifications.Events)
+	}
+	var filterPrefix, filterSuffix pulumi.StringPtrInput
+	if notifications.FilterPrefix != nil {
+		filterPrefix = *notifications.FilterPrefix
+	}
+	if notifications.FilterSuffix != nil {
+		filterSuffix = *notifications.FilterSuffix
+	}
+
+	notificationArgs := &s3.BucketNotificationArgs{
+		Bucket: bucketName,
+	}
+	if notifications.EventBridge != nil && *notifications.EventBridge {
+		notificationArgs.Eventbridge = pulumi.Bool(true)
+	}
+	var dependencies []pulumi.Resource
+
+	var queueArn pulumi.StringInput
+	if notifications.QueueArn != nil {
+		queueArn = *notifications.QueueArn
+	} else if notifications.Queue != nil && *notifications.Queue {
+		queue, err := sqs.NewQueue(ctx, name+"-notification-queue", &sqs.QueueArgs{
+			SqsManagedSseEnabled: pulumi.Bool(true),
+		}, childOpts...)
+		if err != nil {
+			return nil, err
+		}
+		queuePolicy, err := sqs.NewQueuePolicy(ctx, name+"-notification-queue-policy", &sqs.QueuePolicyArgs{
+			QueueUrl: queue.Url,
+			Policy:   s3NotificationPolicy("sqs:SendMessage", queue.Arn, bucketArn, accountId),
+		}, pulumi.Parent(queue))
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, queuePolicy)
+		queueArn = queue.Arn
+		result.queueArn = queue.Arn
+	}
+	if queueArn != nil {
+		notificationArgs.Queues = s3.BucketNotificationQueueArray{
+			&s3.BucketNotificationQueueArgs{
+				QueueArn:     queueArn,
+				Events:       events,
+				FilterPrefix: filterPrefix,
+				FilterSuffix: filterSuffix,
+			},
+		}
+	}
+
+	var topicArn pulumi.StringInput
+	if notifications.TopicArn != nil {
+		topicArn = *notifications.TopicArn
+	} else if notifications.Topic != nil && *notifications.Topic {
+		topic, err := sns.NewTopic(ctx, name+"-notification-topic", &sns.TopicArgs{}, childOpts...)
+		if err != nil {
+			return nil, err
+		}
+		topicPolicy, err := sns.NewTopicPolicy(ctx, name+"-notification-topic-policy", &sns.TopicPolicyArgs{
+			Arn:    topic.Arn,
+			Policy: s3NotificationPolicy("sns:Publish", topic.Arn, bucketArn, accountId),
+		}, pulumi.Parent(topic))
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, topicPolicy)
+		topicArn = topic.Arn
+		result.topicArn = topic.Arn
+	}
+	if topicArn != nil {
+		notificationArgs.Topics = s3.BucketNotificationTopicArray{
+			&s3.BucketNotificationTopicArgs{
+				TopicArn:     topicArn,
+				Events:       events,
+				FilterPrefix: filterPrefix,
+				FilterSuffix: filterSuffix,
+			},
+		}
+	}
+
+	if notifications.LambdaFunctionArn != nil {
+		permission, err := lambda.NewPermission(ctx, name+"-notification-permission", &lambda.PermissionArgs{
+			Action:        pulumi.String("lambda:InvokeFunction"),
+			Function:      *notifications.LambdaFunctionArn,
+			Principal:     pulumi.String("s3.amazonaws.com"),
+			SourceArn:     bucketArn,
+			SourceAccount: pulumi.String(accountId),
+		}, childOpts...)
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, permission)
+		notificationArgs.LambdaFunctions = s3.BucketNotificationLambdaFunctionArray{
+			&s3.BucketNotificationLambdaFunctionArgs{
+				LambdaFunctionArn: *notifications.LambdaFunctionArn,
+				Events:            events,
+				FilterPrefix:      filterPrefix,
+				FilterSuffix:      filterSuffix,
+			},
+		}
+	}
+
+	// S3 sends a test event when the configuration is written, which fails unless the destination
+	// already allows it.
+	if _, err := s3.NewBucketNotification(ctx, name+"-bucket-notification", notificationArgs,
+		append(childOpts, pulumi.DependsOn(dependencies))...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// s3NotificationPolicy returns a resource policy that allows S3 to deliver the event notifications
+// of the bucket in the account to the resource.
+func s3NotificationPolicy(action string, resourceArn, bucketArn pulumi.StringInput, accountId string) pulumi.Input {
+	return pulumi.Any(map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect": "Allow",
+				"Principal": map[string]interface{}{
+					"Service": "s3.amazonaws.com",
+				},
+				"Action":   action,
+				"Resource": resourceArn,
+				"Condition": map[string]interface{}{
+					"ArnEquals": map[string]interface{}{
+						"aws:SourceArn": bucketArn,
+					},
+					"StringEquals": map[string]interface{}{
+						"aws:SourceAccount": accountId,
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/cloudfront"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/iam"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/s3"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The IDs of the origins of a FileHosting distribution.
+const (
+	primaryOriginId  = "S3-origin"
+	failoverOriginId = "S3-failover-origin"
+	originGroupId    = "S3-origin-group"
+)
+
+// The status codes of the bucket a FileHosting fails over on by default.
+var defaultFailoverStatusCodes = []int{500, 502, 503, 504, 403, 404}
+
+// The status codes CloudFront accepts as failover criteria of an origin group.
+var failoverStatusCodes = []int{400, 403, 404, 416, 500, 502, 503, 504}
+
+// The failover settings of a FileHosting component resource.
+type FileHostingFailoverArgs struct {
+	// The name of an existing bucket to fail over to. If not provided, a bucket is created.
+	BucketName *pulumi.StringInput `pulumi:"bucketName"`
+	// The region of the failover bucket. Defaults to the region of the stack.
+	BucketRegion *pulumi.StringInput `pulumi:"bucketRegion"`
+	// Whether the objects of the bucket are replicated to the failover bucket. Both buckets need
+	// versioning enabled. Defaults to false.
+	Replication *bool `pulumi:"replication"`
+	// The status codes of the bucket on which CloudFront retries the request with the failover
+	// bucket. Defaults to 500, 502, 503, 504, 403 and 404.
+	StatusCodes []int `pulumi:"statusCodes"`
+}
+
+// validate checks the failover settings against the other arguments of the FileHosting.
+func (f *FileHostingFailoverArgs) validate(args *FileHostingArgs) error {
+	// Origin groups only serve GET, HEAD and OPTIONS requests, so uploads have to go through a
+	// behavior that targets the bucket directly.
+	if mode, _ := args.uploadMode(); mode == uploadModeFull {
+		return fmt.Errorf("failover requires the uploadMode %s or %s", uploadModeReadOnly, uploadModeSignedUpload)
+	}
+	if f.StatusCodes != nil && len(f.StatusCodes) == 0 {
+		return errors.New("failover.statusCodes must not be empty")
+	}
+	for _, statusCode := range f.StatusCodes {
+		if !slices.Contains(failoverStatusCodes, statusCode) {
+			return fmt.Errorf("failover.statusCodes must be in %v, got %d", failoverStatusCodes, statusCode)
+		}
+	}
+	if f.Replication != nil && *f.Replication && args.BucketName == nil {
+		bucket := args.BucketOptions
+		if bucket == nil || bucket.Versioning == nil || !*bucket.Versioning {
+			return errors.New("failover.replication requires bucketOptions.versioning")
+		}
+		if bucket.Encryption != nil && *bucket.Encryption == bucketEncryptionSseKms {
+			return fmt.Errorf("failover.replication does not support the %s encryption of the bucket", bucketEncryptionSseKms)
+		}
+	}
+	return nil
+}
+
+// failoverBucket is the bucket a FileHosting fails over to.
+type failoverBucket struct {
+	name               pulumi.StringInput
+	regionalDomainName pulumi.StringInput
+	// The options of the resources managing the bucket, with a provider for its region if given.
+	opts       []pulumi.ResourceOption
+	invokeOpts []pulumi.InvokeOption
+	// The versioning of a created bucket, nil if versioning is disabled.
+	versioning *s3.BucketVersioningV2
+}
+
+// newFileHostingFailoverBucket looks up the given failover bucket or creates one. A created bucket
+// blocks public access and is versioned when the objects are replicated to it.
+func newFileHostingFailoverBucket(ctx *pulumi.Context, component pulumi.Resource, name string,
+	failover *FileHostingFailoverArgs) (*failoverBucket, error) {
+	result := &failoverBucket{}
+	var region pulumi.StringInput
+	if failover.BucketRegion != nil {
+		failoverProvider, err := aws.NewProvider(ctx, name+"-failover-bucket-region", &aws.ProviderArgs{
+			Region: *failover.BucketRegion,
+		}, pulumi.Parent(component))
+		if err != nil {
+			return nil, err
+		}
+		region = *failover.BucketRegion
+		result.opts = append(result.opts, pulumi.Provider(failoverProvider))
+		result.invokeOpts = append(result.invokeOpts, pulumi.Provider(failoverProvider))
+	} else {
+		currentRegion, err := aws.GetRegion(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		region = pulumi.String(currentRegion.Name)
+	}
+
+	if failover.BucketName != nil {
+		result.name = *failover.BucketName
+		result.regionalDomainName = lookUpBucketRegionalDomainName(ctx, *failover.BucketName, region, result.invokeOpts...)
+		return result, nil
+	}
+
+	bucket, err := s3.NewBucket(ctx, name+"-failover-bucket", &s3.BucketArgs{},
+		append([]pulumi.ResourceOption{pulumi.Parent(component)}, result.opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s3.NewBucketOwnershipControls(ctx, name+"-failover-bucket-ownership-controls", &s3.BucketOwnershipControlsArgs{
+		Bucket: bucket.ID(),
+		Rule: &s3.BucketOwnershipControlsRuleArgs{
+			ObjectOwnership: pulumi.String("BucketOwnerEnforced"),
+		},
+	}, pulumi.Parent(bucket)); err != nil {
+		return nil, err
+	}
+	if _, err := s3.NewBucketPublicAccessBlock(ctx, name+"-failover-bucket-public-access-block", &s3.BucketPublicAccessBlockArgs{
+		Bucket:                bucket.ID(),
+		BlockPublicPolicy:     pulumi.Bool(true),
+		BlockPublicAcls:       pulumi.Bool(true),
+		IgnorePublicAcls:      pulumi.Bool(true),
+		RestrictPublicBuckets: pulumi.Bool(true),
+	}, pulumi.Parent(bucket)); err != nil {
+		return nil, err
+	}
+	if _, err := s3.NewBucketServerSideEncryptionConfigurationV2(ctx, name+"-failover-bucket-encryption", &s3.BucketServerSideEncryptionConfigurationV2Args{
+		Bucket: bucket.ID(),
+		Rules: s3.BucketServerSideEncryptionConfigurationV2RuleArray{
+			&s3.BucketServerSideEncryptionConfigurationV2RuleArgs{
+				ApplyServerSideEncryptionByDefault: &s3.BucketServerSideEncryptionConfigurationV2RuleApplyServerSideEncryptionByDefaultArgs{
+					SseAlgorithm: pulumi.String("AES256"),
+				},
+			},
+		},
+	}, pulumi.Parent(bucket)); err != nil {
+		return nil, err
+	}
+	if failover.Replication != nil && *failover.Replication {
+		versioning, err := s3.NewBucketVersioningV2(ctx, name+"-failover-bucket-versioning", &s3.BucketVersioningV2Args{
+			Bucket: bucket.ID(),
+			VersioningConfiguration: &s3.BucketVersioningV2VersioningConfigurationArgs{
+				Status: pulumi.String("Enabled"),
+			},
+		}, pulumi.Parent(bucket))
+		if err != nil {
+			return nil, err
+		}
+		result.versioning = versioning
+	}
+
+	result.name = bucket.Bucket
+	result.regionalDomainName = bucket.BucketRegionalDomainName
+	return result, nil
+}
+
+// newFileHostingReplication replicates the objects of the bucket, including deletions, to the
+// failover bucket. The options carry the provider of the region of the bucket.
+func newFileHostingReplication(ctx *pulumi.Context, component pulumi.Resource, name string,
+	bucketName pulumi.StringInput, failover *failoverBucket, dependencies []pulumi.Resource,
+	opts ...pulumi.ResourceOption) error {
+	role, err := iam.NewRole(ctx, name+"-replication-role", &iam.RoleArgs{
+		AssumeRolePolicy: pulumi.Any(map[string]interface{}{
+			"Version": "2012-10-17",
+			"Statement": []map[string]interface{}{
+				{
+					"Effect": "Allow",
+					"Principal": map[string]interface{}{
+						"Service": "s3.amazonaws.com",
+					},
+					"Action": "sts:AssumeRole",
+				},
+			},
+		}),
+	}, pulumi.Parent(component))
+	if err != nil {
+		return err
+	}
+
+	rolePolicy, err := iam.NewRolePolicy(ctx, name+"-replication-role-policy", &iam.RolePolicyArgs{
+		Role: role.ID(),
+		Policy: pulumi.Any(map[string]interface{}{
+			"Version": "2012-10-17",
+			"Statement": []map[string]interface{}{
+				{
+					"Effect": "Allow",
+					"Action": []interface{}{
+						"s3:GetReplicationConfiguration",
+						"s3:ListBucket",
+					},
+					"Resource": []interface{}{
+						pulumi.Sprintf("arn:aws:s3:::%s", bucketName),
+					},
+				},
+				{
+					"Effect": "Allow",
+					"Action": []interface{}{
+						"s3:GetObjectVersionForReplication",
+						"s3:GetObjectVersionAcl",
+						"s3:GetObjectVersionTagging",
+					},
+					"Resource": []interface{}{
+						pulumi.Sprintf("arn:aws:s3:::%s/*", bucketName),
+					},
+				},
+				{
+					"Effect": "Allow",
+					"Action": []interface{}{
+						"s3:ReplicateObject",
+						"s3:ReplicateDelete",
+						"s3:ReplicateTags",
+					},
+					"Resource": []interface{}{
+						pulumi.Sprintf("arn:aws:s3:::%s/*", failover.name),
+					},
+				},
+			},
+		}),
+	}, pulumi.Parent(role))
+	if err != nil {
+		return err
+	}
+
+	dependencies = append(dependencies, rolePolicy)
+	if failover.versioning != nil {
+		dependencies = append(dependencies, failover.versioning)
+	}
+	_, err = s3.NewBucketReplicationConfig(ctx, name+"-replication", &s3.BucketReplicationConfigArgs{
+		Bucket: bucketName,
+		Role:   role.Arn,
+		Rules: s3.BucketReplicationConfigRuleArray{
+			&s3.BucketReplicationConfigRuleArgs{
+				Id:     pulumi.String("failover"),
+				Status: pulumi.String("Enabled"),
+				Filter: &s3.BucketReplicationConfigRuleFilterArgs{},
+				DeleteMarkerReplication: &s3.BucketReplicationConfigRuleDeleteMarkerReplicationArgs{
+					Status: pulumi.String("Enabled"),
+				},
+				Destination: &s3.BucketReplicationConfigRuleDestinationArgs{
+					Bucket: pulumi.Sprintf("arn:aws:s3:::%s", failover.name),
+				},
+			},
+		},
+	}, append([]pulumi.ResourceOption{pulumi.Parent(component), pulumi.DependsOn(dependencies)}, opts...)...)
+	return err
+}
+
+// distributionOrigin returns an origin of the distribution for the bucket with the given regional
+// domain name. With an Origin Shield region, requests to the bucket go through Origin Shield.
+func distributionOrigin(originId string, domainName, originAccessControlId pulumi.StringInput,
+	originShieldRegion *pulumi.StringInput) *cloudfront.DistributionOriginArgs {
+	origin := &cloudfront.DistributionOriginArgs{
+		DomainName:            domainName,
+		OriginId:              pulumi.String(originId),
+		OriginAccessControlId: originAccessControlId,
+	}
+	if originShieldRegion != nil {
+		origin.OriginShield = &cloudfront.DistributionOriginOriginShieldArgs{
+			Enabled:            pulumi.Bool(true),
+			OriginShieldRegion: *originShieldRegion,
+		}
+	}
+	return origin
+}
+
+// distributionOriginGroup returns the origin group that fails over from the bucket to the
+// failover bucket on the given status codes.
+func distributionOriginGroup(statusCodes []int) *cloudfront.DistributionOriginGroupArgs {
+	if statusCodes == nil {
+		statusCodes = defaultFailoverStatusCodes
+	}
+	return &cloudfront.DistributionOriginGroupArgs{
+		OriginId: pulumi.String(originGroupId),
+		FailoverCriteria: &cloudfront.DistributionOriginGroupFailoverCriteriaArgs{
+			StatusCodes: pulumi.ToIntArray(statusCodes),
+		},
+		Members: cloudfront.DistributionOriginGroupMemberArray{
+			&cloudfront.DistributionOriginGroupMemberArgs{
+				OriginId: pulumi.String(primaryOriginId),
+			},
+			&cloudfront.DistributionOriginGroupMemberArgs{
+				OriginId: pulumi.String(failoverOriginId),
+			},
+		},
+	}
+}
@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/cloudfront"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The settings of the cache policy a FileHosting component resource creates.
+type FileHostingCachePolicyArgs struct {
+	// The default time objects stay in the cache, in seconds. Defaults to 86400.
+	DefaultTtl *pulumi.IntInput `pulumi:"defaultTtl"`
+	// The maximum time objects stay in the cache, in seconds. Defaults to 31536000.
+	MaxTtl *pulumi.IntInput `pulumi:"maxTtl"`
+	// The minimum time objects stay in the cache, in seconds. Defaults to 1.
+	MinTtl *pulumi.IntInput `pulumi:"minTtl"`
+	// Which query strings are part of the cache key: none, whitelist, allExcept or all. Defaults
+	// to whitelist.
+	QueryStringBehavior *pulumi.StringInput `pulumi:"queryStringBehavior"`
+	// The query strings the query string behavior applies to. Defaults to etag.
+	QueryStrings *pulumi.StringArrayInput `pulumi:"queryStrings"`
+	// Which headers are part of the cache key: none or whitelist. Defaults to none.
+	HeaderBehavior *pulumi.StringInput `pulumi:"headerBehavior"`
+	// The headers the header behavior applies to.
+	Headers *pulumi.StringArrayInput `pulumi:"headers"`
+	// Which cookies are part of the cache key: none, whitelist, allExcept or all. Defaults to
+	// none.
+	CookieBehavior *pulumi.StringInput `pulumi:"cookieBehavior"`
+	// The cookies the cookie behavior applies to.
+	Cookies *pulumi.StringArrayInput `pulumi:"cookies"`
+	// Whether gzip compressed objects are cached and served. Defaults to false.
+	EnableAcceptEncodingGzip *pulumi.BoolInput `pulumi:"enableAcceptEncodingGzip"`
+	// Whether brotli compressed objects are cached and served. Defaults to false.
+	EnableAcceptEncodingBrotli *pulumi.BoolInput `pulumi:"enableAcceptEncodingBrotli"`
+}
+
+// The settings of the origin request policy a FileHosting component resource creates.
+type FileHostingOriginRequestPolicyArgs struct {
+	// Which query strings are forwarded to the bucket: none, whitelist, allExcept or all.
+	// Defaults to whitelist.
+	QueryStringBehavior *pulumi.StringInput `pulumi:"queryStringBehavior"`
+	// The query strings the query string behavior applies to. Defaults to partNumber and
+	// uploadId, which multipart uploads need.
+	QueryStrings *pulumi.StringArrayInput `pulumi:"queryStrings"`
+	// Which headers are forwarded to the bucket: none, whitelist, allViewer,
+	// allViewerAndWhitelistCloudFront or allExcept. Defaults to whitelist.
+	HeaderBehavior *pulumi.StringInput `pulumi:"headerBehavior"`
+	// The headers the header behavior applies to. Defaults to Content-Type.
+	Headers *pulumi.StringArrayInput `pulumi:"headers"`
+	// Which cookies are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to
+	// none.
+	CookieBehavior *pulumi.StringInput `pulumi:"cookieBehavior"`
+	// The cookies the cookie behavior applies to.
+	Cookies *pulumi.StringArrayInput `pulumi:"cookies"`
+}
+
+// The CORS settings of the response headers policy of a FileHosting component resource.
+type FileHostingCorsArgs struct {
+	// The origins allowed to access the files. Defaults to all origins.
+	AllowedOrigins []string `pulumi:"allowedOrigins"`
+	// The HTTP methods allowed in cross-origin requests. Defaults to ALL.
+	AllowedMethods *pulumi.StringArrayInput `pulumi:"allowedMethods"`
+	// The headers allowed in cross-origin requests. Defaults to all headers.
+	AllowedHeaders *pulumi.StringArrayInput `pulumi:"allowedHeaders"`
+	// The headers exposed to cross-origin responses. Defaults to ETag, which multipart uploads need.
+	ExposeHeaders *pulumi.StringArrayInput `pulumi:"exposeHeaders"`
+	// The time browsers may cache preflight responses, in seconds. Defaults to 600.
+	MaxAgeSeconds *pulumi.IntInput `pulumi:"maxAgeSeconds"`
+	// Whether cross-origin requests may include credentials. Requires explicit allowed origins.
+	// Defaults to false.
+	AllowCredentials *bool `pulumi:"allowCredentials"`
+}
+
+// validate checks that credentials are only allowed for explicit origins, as browsers reject
+// credentialed responses that allow all origins.
+func (c *FileHostingCorsArgs) validate() error {
+	if c.AllowCredentials == nil || !*c.AllowCredentials {
+		return nil
+	}
+	if len(c.AllowedOrigins) == 0 || slices.Contains(c.AllowedOrigins, "*") {
+		return errors.New("cors.allowCredentials requires cors.allowedOrigins without *")
+	}
+	return nil
+}
+
+// The security headers of the response headers policy of a FileHosting component resource.
+type FileHostingSecurityHeadersArgs struct {
+	// The max age of the Strict-Transport-Security header, in seconds. Defaults to 31536000.
+	StrictTransportSecurityMaxAge *pulumi.IntInput `pulumi:"strictTransportSecurityMaxAge"`
+	// Whether the Strict-Transport-Security header includes subdomains. Defaults to true.
+	StrictTransportSecurityIncludeSubdomains *pulumi.BoolInput `pulumi:"strictTransportSecurityIncludeSubdomains"`
+	// Whether the Strict-Transport-Security header allows preloading. Defaults to false.
+	StrictTransportSecurityPreload *pulumi.BoolInput `pulumi:"strictTransportSecurityPreload"`
+	// The Content-Security-Policy header. Defaults to default-src 'none', so hosted files cannot
+	// load or run anything when opened directly.
+	ContentSecurityPolicy *pulumi.StringInput `pulumi:"contentSecurityPolicy"`
+}
+
+// cachePolicyArgs returns the arguments of the cache policy. Settings that are not given keep the
+// values FileHosting has always used.
+func (p *FileHostingCachePolicyArgs) cachePolicyArgs() *cloudfront.CachePolicyArgs {
+	if p == nil {
+		p = &FileHostingCachePolicyArgs{}
+	}
+
+	queryStringBehavior, queryStrings := policyItems(p.QueryStringBehavior, p.QueryStrings, "whitelist", "etag")
+	headerBehavior, headers := policyItems(p.HeaderBehavior, p.Headers, "none")
+	cookieBehavior, cookies := policyItems(p.CookieBehavior, p.Cookies, "none")
+
+	cookiesConfig := &cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginCookiesConfigArgs{
+		CookieBehavior: cookieBehavior,
+	}
+	if cookies != nil {
+		cookiesConfig.Cookies = &cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginCookiesConfigCookiesArgs{
+			Items: cookies,
+		}
+	}
+	headersConfig := &cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginHeadersConfigArgs{
+		HeaderBehavior: headerBehavior,
+	}
+	if headers != nil {
+		headersConfig.Headers = &cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginHeadersConfigHeadersArgs{
+			Items: headers,
+		}
+	}
+	queryStringsConfig := &cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginQueryStringsConfigArgs{
+		QueryStringBehavior: queryStringBehavior,
+	}
+	if queryStrings != nil {
+		queryStringsConfig.QueryStrings = &cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginQueryStringsConfigQueryStringsArgs{
+			Items: queryStrings,
+		}
+	}
+	cacheKey := cloudfront.CachePolicyParametersInCacheKeyAndForwardedToOriginArgs{
+		CookiesConfig:              cookiesConfig,
+		EnableAcceptEncodingBrotli: pulumi.Bool(false),
+		EnableAcceptEncodingGzip:   pulumi.Bool(false),
+		HeadersConfig:              headersConfig,
+		QueryStringsConfig:         queryStringsConfig,
+	}
+	if p.EnableAcceptEncodingGzip != nil {
+		cacheKey.EnableAcceptEncodingGzip = *p.EnableAcceptEncodingGzip
+	}
+	if p.EnableAcceptEncodingBrotli != nil {
+		cacheKey.EnableAcceptEncodingBrotli = *p.EnableAcceptEncodingBrotli
+	}
+
+	cachePolicyArgs := &cloudfront.CachePolicyArgs{
+		DefaultTtl:                               pulumi.Int(86400),
+		MaxTtl:                                   pulumi.Int(31536000),
+		MinTtl:                                   pulumi.Int(1),
+		ParametersInCacheKeyAndForwardedToOrigin: cacheKey,
+	}
+	if p.DefaultTtl != nil {
+		cachePolicyArgs.DefaultTtl = *p.DefaultTtl
+	}
+	if p.MaxTtl != nil {
+		cachePolicyArgs.MaxTtl = *p.MaxTtl
+	}
+	if p.MinTtl != nil {
+		cachePolicyArgs.MinTtl = *p.MinTtl
+	}
+	return cachePolicyArgs
+}
+
+// originRequestPolicyArgs returns the arguments of the origin request policy. Settings that are
+// not given keep the values FileHosting has always used.
+func (p *FileHostingOriginRequestPolicyArgs) originRequestPolicyArgs() *cloudfront.OriginRequestPolicyArgs {
+	if p == nil {
+		p = &FileHostingOriginRequestPolicyArgs{}
+	}
+
+	queryStringBehavior, queryStrings := policyItems(p.QueryStringBehavior, p.QueryStrings, "whitelist", "partNumber", "uploadId")
+	headerBehavior, headers := policyItems(p.HeaderBehavior, p.Headers, "whitelist", "Content-Type")
+	cookieBehavior, cookies := policyItems(p.CookieBehavior, p.Cookies, "none")
+
+	cookiesConfig := &cloudfront.OriginRequestPolicyCookiesConfigArgs{
+		CookieBehavior: cookieBehavior,
+	}
+	if cookies != nil {
+		cookiesConfig.Cookies = &cloudfront.OriginRequestPolicyCookiesConfigCookiesArgs{
+			Items: cookies,
+		}
+	}
+	headersConfig := &cloudfront.OriginRequestPolicyHeadersConfigArgs{
+		HeaderBehavior: headerBehavior,
+	}
+	if headers != nil {
+		headersConfig.Headers = &cloudfront.OriginRequestPolicyHeadersConfigHeadersArgs{
+			Items: headers,
+		}
+	}
+	queryStringsConfig := &cloudfront.OriginRequestPolicyQueryStringsConfigArgs{
+		QueryStringBehavior: queryStringBehavior,
+	}
+	if queryStrings != nil {
+		queryStringsConfig.QueryStrings = &cloudfront.OriginRequestPolicyQueryStringsConfigQueryStringsArgs{
+			Items: queryStrings,
+		}
+	}
+	return &cloudfront.OriginRequestPolicyArgs{
+		CookiesConfig:      cookiesConfig,
+		HeadersConfig:      headersConfig,
+		QueryStringsConfig: queryStringsConfig,
+	}
+}
+
+// responseHeadersPolicyArgs returns the arguments of the response headers policy with the CORS
+// settings and the security headers. X-Content-Type-Options is always set to nosniff.
+func responseHeadersPolicyArgs(cors *FileHostingCorsArgs, securityHeaders *FileHostingSecurityHeadersArgs) *cloudfront.ResponseHeadersPolicyArgs {
+	if cors == nil {
+		cors = &FileHostingCorsArgs{}
+	}
+	if securityHeaders == nil {
+		securityHeaders = &FileHostingSecurityHeadersArgs{}
+	}
+
+	var allowedOrigins pulumi.StringArrayInput = pulumi.StringArray{pulumi.String("*")}
+	if cors.AllowedOrigins != nil {
+		allowedOrigins = pulumi.ToStringArray(cors.AllowedOrigins)
+	}
+	var allowedMethods pulumi.StringArrayInput = pulumi.StringArray{pulumi.String("ALL")}
+	if cors.AllowedMethods != nil {
+		allowedMethods = *cors.AllowedMethods
+	}
+	var allowedHeaders pulumi.StringArrayInput = pulumi.StringArray{pulumi.String("*")}
+	if cors.AllowedHeaders != nil {
+		allowedHeaders = *cors.AllowedHeaders
+	}
+	var exposeHeaders pulumi.StringArrayInput = pulumi.StringArray{pulumi.String("ETag")}
+	if cors.ExposeHeaders != nil {
+		exposeHeaders = *cors.ExposeHeaders
+	}
+	corsConfig := &cloudfront.ResponseHeadersPolicyCorsConfigArgs{
+		AccessControlAllowCredentials: pulumi.Bool(false),
+		AccessControlAllowHeaders: &cloudfront.ResponseHeadersPolicyCorsConfigAccessControlAllowHeadersArgs{
+			Items: allowedHeaders,
+		},
+		AccessControlAllowMethods: &cloudfront.ResponseHeadersPolicyCorsConfigAccessControlAllowMethodsArgs{
+			Items: allowedMethods,
+		},
+		AccessControlAllowOrigins: &cloudfront.ResponseHeadersPolicyCorsConfigAccessControlAllowOriginsArgs{
+			Items: allowedOrigins,
+		},
+		AccessControlExposeHeaders: &cloudfront.ResponseHeadersPolicyCorsConfigAccessControlExposeHeadersArgs{
+			Items: exposeHeaders,
+		},
+		AccessControlMaxAgeSec: pulumi.Int(600),
+		OriginOverride:         pulumi.Bool(true),
+	}
+	if cors.AllowCredentials != nil {
+		corsConfig.AccessControlAllowCredentials = pulumi.Bool(*cors.AllowCredentials)
+	}
+	if cors.MaxAgeSeconds != nil {
+		corsConfig.AccessControlMaxAgeSec = *cors.MaxAgeSeconds
+	}
+
+	strictTransportSecurity := &cloudfront.ResponseHeadersPolicySecurityHeadersConfigStrictTransportSecurityArgs{
+		AccessControlMaxAgeSec: pulumi.Int(31536000),
+		IncludeSubdomains:      pulumi.Bool(true),
+		Preload:                pulumi.Bool(false),
+		Override:               pulumi.Bool(true),
+	}
+	if securityHeaders.StrictTransportSecurityMaxAge != nil {
+		strictTransportSecurity.AccessControlMaxAgeSec = *securityHeaders.StrictTransportSecurityMaxAge
+	}
+	if securityHeaders.StrictTransportSecurityIncludeSubdomains != nil {
+		strictTransportSecurity.IncludeSubdomains = *securityHeaders.StrictTransportSecurityIncludeSubdomains
+	}
+	if securityHeaders.StrictTransportSecurityPreload != nil {
+		strictTransportSecurity.Preload = *securityHeaders.StrictTransportSecurityPreload
+	}
+	var contentSecurityPolicy pulumi.StringInput = pulumi.String("default-src 'none'")
+	if securityHeaders.ContentSecurityPolicy != nil {
+		contentSecurityPolicy = *securityHeaders.ContentSecurityPolicy
+	}
+
+	return &cloudfront.ResponseHeadersPolicyArgs{
+		Comment:    pulumi.String("Response headers policy for FileHosting"),
+		CorsConfig: corsConfig,
+		SecurityHeadersConfig: &cloudfront.ResponseHeadersPolicySecurityHeadersConfigArgs{
+			StrictTransportSecurity: strictTransportSecurity,
+			ContentTypeOptions: &cloudfront.ResponseHeadersPolicySecurityHeadersConfigContentTypeOptionsArgs{
+				Override: pulumi.Bool(true),
+			},
+			ContentSecurityPolicy: &cloudfront.ResponseHeadersPolicySecurityHeadersConfigContentSecurityPolicyArgs{
+				ContentSecurityPolicy: contentSecurityPolicy,
+				Override:              pulumi.Bool(true),
+			},
+		},
+	}
+}
+
+// policyItems returns the behavior and the items of a query string, header or cookie
+// configuration. Without a behavior, given items are whitelisted. Without both, the defaults are
+// used.
+func policyItems(behavior *pulumi.StringInput, items *pulumi.StringArrayInput,
+	defaultBehavior string, defaultItems ...string) (pulumi.StringInput, pulumi.StringArrayInput) {
+	switch {
+	case behavior != nil && items != nil:
+		return *behavior, *items
+	case behavior != nil:
+		return *behavior, nil
+	case items != nil:
+		return pulumi.String("whitelist"), *items
+	case len(defaultItems) > 0:
+		return pulumi.String(defaultBehavior), pulumi.ToStringArray(defaultItems)
+	default:
+		return pulumi.String(defaultBehavior), nil
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/ssm"
+	"github.com/pulumi/pulumi-gotiac/pkg/signer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The set of arguments for the FileHosting signUrl and signCookies methods.
+type FileHostingSignArgs struct {
+	// The path of the file, relative to the file hosting URL. It is the unescaped object key, which
+	// is escaped for the URL. Cookies may use * wildcards.
+	Path pulumi.StringInput `pulumi:"path"`
+	// The time the signature expires at, in RFC 3339 format.
+	ExpiresAt pulumi.StringInput `pulumi:"expiresAt"`
+	// The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+	StartsAt *pulumi.StringInput `pulumi:"startsAt"`
+	// The IP address or CIDR range access is restricted to. Requires a custom policy.
+	IpAddress *pulumi.StringInput `pulumi:"ipAddress"`
+	// Whether to sign with the upload key. Requires the signed-upload upload mode.
+	Upload *bool `pulumi:"upload"`
+}
+
+// The result of the FileHosting signUrl method.
+type FileHostingSignUrlResult struct {
+	Url pulumi.StringOutput `pulumi:"url"`
+}
+
+// The result of the FileHosting signCookies method.
+type FileHostingSignCookiesResult struct {
+	Cookies pulumi.StringMapOutput `pulumi:"cookies"`
+}
+
+// SignUrl returns a signed URL for a file of the FileHosting.
+func (c *FileHosting) SignUrl(ctx *pulumi.Context, args *FileHostingSignArgs) (*FileHostingSignUrlResult, error) {
+	url := c.signingInputs(ctx, args).ApplyT(func(inputs []interface{}) (string, error) {
+		s, policy, err := newSigningRequest(inputs)
+		if err != nil {
+			return "", err
+		}
+		return s.SignUrlWithPolicy(policy.Resource, policy)
+	}).(pulumi.StringOutput)
+
+	return &FileHostingSignUrlResult{Url: url}, nil
+}
+
+// SignCookies returns the signed cookies granting access to files of the FileHosting.
+func (c *FileHosting) SignCookies(ctx *pulumi.Context, args *FileHostingSignArgs) (*FileHostingSignCookiesResult, error) {
+	cookies := c.signingInputs(ctx, args).ApplyT(func(inputs []interface{}) (map[string]string, error) {
+		s, policy, err := newSigningRequest(inputs)
+		if err != nil {
+			return nil, err
+		}
+		return s.SignCookiesWithPolicy(policy)
+	}).(pulumi.StringMapOutput)
+
+	return &FileHostingSignCookiesResult{Cookies: cookies}, nil
+}
+
+// signingInputs reads the private key of the FileHosting from SSM and combines it with the
+// arguments of a signing method.
+func (c *FileHosting) signingInputs(ctx *pulumi.Context, args *FileHostingSignArgs) pulumi.ArrayOutput {
+	keyId, keyParameterName := c.PrivateKeyId, c.whenDistributionCreated(c.PrivateKeyParameterName)
+	if args.Upload != nil && *args.Upload {
+		keyId = c.UploadPrivateKeyId
+		keyParameterName = c.whenDistributionCreated(c.UploadPrivateKeyParameterName).ApplyT(func(name string) (string, error) {
+			if name == "" {
+				return "", errors.New("the file hosting has no upload key, its upload mode has to be signed-upload")
+			}
+			return name, nil
+		}).(pulumi.StringOutput)
+	}
+	privateKey := ssm.LookupParameterOutput(ctx, ssm.LookupParameterOutputArgs{
+		Name:           keyParameterName,
+		WithDecryption: pulumi.Bool(true),
+	})
+
+	var startsAt, ipAddress pulumi.StringInput = pulumi.String(""), pulumi.String("")
+	if args.StartsAt != nil {
+		startsAt = *args.StartsAt
+	}
+	if args.IpAddress != nil {
+		ipAddress = *args.IpAddress
+	}
+
+	return pulumi.All(c.Url, keyId, privateKey.Value(), args.Path, args.ExpiresAt, startsAt, ipAddress)
+}
+
+// newSigningRequest creates the signer and the policy for the resolved signing inputs.
+func newSigningRequest(inputs []interface{}) (*signer.Signer, signer.Policy, error) {
+	domain, keyId, privateKeyPem := inputs[0].(string), inputs[1].(string), inputs[2].(string)
+	path, expiresAt, startsAt, ipAddress := inputs[3].(string), inputs[4].(string), inputs[5].(string), inputs[6].(string)
+
+	s, err := signer.New(keyId, privateKeyPem)
+	if err != nil {
+		return nil, signer.Policy{}, err
+	}
+	policy := signer.Policy{
+		Resource:  signer.ObjectUrl("https://"+domain, path),
+		IpAddress: ipAddress,
+	}
+	if policy.Expires, err = time.Parse(time.RFC3339, expiresAt); err != nil {
+		return nil, signer.Policy{}, errors.Wrap(err, "parsing expiresAt")
+	}
+	if startsAt != "" {
+		if policy.Starts, err = time.Parse(time.RFC3339, startsAt); err != nil {
+			return nil, signer.Policy{}, errors.Wrap(err, "parsing startsAt")
+		}
+	}
+	return s, policy, nil
+}
@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/iam"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/route53"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/ses"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/ssm"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The number of DKIM tokens SES issues for a domain identity.
+const sesDkimTokenCount = 3
+
+// The set of arguments for creating a MailUser component resource.
+type MailUserArgs struct {
+	// The region of the SES identity and SMTP endpoint. Defaults to the region of the stack.
+	Region *pulumi.StringInput `pulumi:"region"`
+	// The mail domain.
+	Domain pulumi.StringInput `pulumi:"domain"`
+	// The display name used in the sender address.
+	DisplayName *pulumi.StringInput `pulumi:"displayName"`
+	// The name of the mailbox.
+	Name *pulumi.StringInput `pulumi:"name"`
+	// The local part of the email address.
+	EmailPrefix pulumi.StringInput `pulumi:"emailPrefix"`
+	// Whether the SMTP credentials are active. Defaults to true.
+	Enabled *pulumi.BoolInput `pulumi:"enabled"`
+	// A seed that only triggers the rotation of the SMTP credentials: changing it replaces the
+	// access key. The credentials are generated by IAM, not derived from the seed, so it is not a
+	// secret.
+	PasswordSeed *string `pulumi:"passwordSeed"`
+	// The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by
+	// the domain and its parent domains.
+	HostedZoneId *pulumi.StringInput `pulumi:"hostedZoneId"`
+	// Whether to create the DNS records in Route 53. If false, no records are created and the
+	// records to create in an external DNS provider are returned as outputs. Mail can be sent once
+	// SES has verified the domain through them. Defaults to true.
+	ManageDns *bool `pulumi:"manageDns"`
+	// The ARN of the SES identity of the domain, e.g. the domainIdentityArn output of another
+	// MailUser of the domain. If provided, the identity and its DNS records are not created, so
+	// that mailboxes of one domain do not compete for them.
+	DomainIdentityArn *pulumi.StringInput `pulumi:"domainIdentityArn"`
+}
+
+// The MailUser component resource.
+type MailUser struct {
+	pulumi.ResourceState
+
+	EmailAddress              pulumi.StringOutput         `pulumi:"emailAddress"`
+	From                      pulumi.StringOutput         `pulumi:"from"`
+	SmtpEndpoint              pulumi.StringOutput         `pulumi:"smtpEndpoint"`
+	SmtpUsernameParameterName pulumi.StringOutput         `pulumi:"smtpUsernameParameterName"`
+	SmtpPasswordParameterName pulumi.StringOutput         `pulumi:"smtpPasswordParameterName"`
+	DnsRecords                pulumi.StringMapArrayOutput `pulumi:"dnsRecords"`
+	DomainIdentityArn         pulumi.StringOutput         `pulumi:"domainIdentityArn"`
+}
+
+// NewMailUser creates a new MailUser component resource.
+func NewMailUser(ctx *pulumi.Context,
+	name string, args *MailUserArgs, opts ...pulumi.ResourceOption) (*MailUser, error) {
+	if args == nil {
+		args = &MailUserArgs{}
+	}
+
+	component := &MailUser{}
+	err := ctx.RegisterComponentResource("gotiac:index:MailUser", name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// SES identities and SMTP passwords are regional, so all SES resources use the same provider.
+	sesOpts := []pulumi.ResourceOption{pulumi.Parent(component)}
+	var region pulumi.StringOutput
+	if args.Region != nil {
+		sesProvider, err := aws.NewProvider(ctx, name+"-ses", &aws.ProviderArgs{
+			Region: *args.Region,
+		}, pulumi.Parent(component))
+		if err != nil {
+			return nil, err
+		}
+		sesOpts = append(sesOpts, pulumi.Provider(sesProvider))
+		region = (*args.Region).ToStringOutput()
+	} else {
+		currentRegion, err := aws.GetRegion(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		region = pulumi.String(currentRegion.Name).ToStringOutput()
+	}
+
+	emailAddress := pulumi.Sprintf("%s@%s", args.EmailPrefix, args.Domain)
+
+	// Create the SES domain identity, unless it is shared with other mailboxes of the domain.
+	manageDns := args.ManageDns == nil || *args.ManageDns
+	var identity *mailDomainIdentity
+	if args.DomainIdentityArn != nil {
+		identity = &mailDomainIdentity{
+			arn:        (*args.DomainIdentityArn).ToStringOutput(),
+			dnsRecords: pulumi.StringMapArray{}.ToStringMapArrayOutput(),
+		}
+	} else {
+		identity, err = newMailDomainIdentity(ctx, component, name, args, manageDns, sesOpts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the IAM user whose access key serves as SMTP credentials. The user may only send
+	// mail from its own address.
+	tags := pulumi.StringMap{
+		"EmailAddress": emailAddress,
+	}
+	if args.Name != nil {
+		tags["Name"] = *args.Name
+	}
+	if args.DisplayName != nil {
+		tags["DisplayName"] = *args.DisplayName
+	}
+	smtpUser, err := iam.NewUser(ctx, name+"-smtp-user", &iam.UserArgs{
+		Tags: tags,
+	}, pulumi.Parent(component))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := iam.NewUserPolicy(ctx, name+"-smtp-user-policy", &iam.UserPolicyArgs{
+		User: smtpUser.Name,
+		Policy: pulumi.Any(map[string]interface{}{
+			"Version": "2012-10-17",
+			"Statement": []map[string]interface{}{
+				{
+					"Effect": "Allow",
+					"Action": []interface{}{
+						"ses:SendRawEmail",
+					},
+					"Resource": "*",
+					"Condition": map[string]interface{}{
+						"StringEquals": map[string]interface{}{
+							"ses:FromAddress": emailAddress,
+						},
+					},
+				},
+			},
+		}),
+	}, pulumi.Parent(smtpUser)); err != nil {
+		return nil, err
+	}
+
+	status := pulumi.String("Active").ToStringOutput()
+	if args.Enabled != nil {
+		status = (*args.Enabled).ToBoolOutput().ApplyT(func(enabled bool) string {
+			if enabled {
+				return "Active"
+			}
+			return "Inactive"
+		}).(pulumi.StringOutput)
+	}
+	// The SMTP password is derived from the access key secret for the SES region, so the key is
+	// created with the SES provider. A hash of the seed is part of the name of the key, so a new
+	// seed replaces the key.
+	accessKeyName := name + "-smtp-credentials"
+	if args.PasswordSeed != nil {
+		seedHash := sha256.Sum256([]byte(*args.PasswordSeed))
+		accessKeyName += "-" + hex.EncodeToString(seedHash[:])[:8]
+	}
+	accessKey, err := iam.NewAccessKey(ctx, accessKeyName, &iam.AccessKeyArgs{
+		User:   smtpUser.Name,
+		Status: status,
+	}, append(sesOpts, pulumi.DependsOn(identity.dependencies))...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store the SMTP credentials in SSM.
+	parameterPath := pulumi.Sprintf("/gotiac/mail/%s/%s", args.Domain, args.EmailPrefix)
+	smtpUsernameParameter, err := ssm.NewParameter(ctx, name+"-smtp-username", &ssm.ParameterArgs{
+		Name:  pulumi.Sprintf("%s/smtp-username", parameterPath),
+		Type:  pulumi.String("String"),
+		Value: accessKey.ID(),
+	}, pulumi.Parent(component))
+	if err != nil {
+		return nil, err
+	}
+	smtpPasswordParameter, err := ssm.NewParameter(ctx, name+"-smtp-password", &ssm.ParameterArgs{
+		Name:  pulumi.Sprintf("%s/smtp-password", parameterPath),
+		Type:  pulumi.String("SecureString"),
+		Value: accessKey.SesSmtpPasswordV4,
+	}, pulumi.Parent(component))
+	if err != nil {
+		return nil, err
+	}
+
+	component.EmailAddress = emailAddress
+	component.From = emailAddress
+	if args.DisplayName != nil {
+		component.From = pulumi.Sprintf("%s <%s>", *args.DisplayName, emailAddress)
+	}
+	component.SmtpEndpoint = pulumi.Sprintf("email-smtp.%s.amazonaws.com", region)
+	component.SmtpUsernameParameterName = smtpUsernameParameter.Name
+	component.SmtpPasswordParameterName = smtpPasswordParameter.Name
+	component.DnsRecords = identity.dnsRecords
+	component.DomainIdentityArn = identity.arn
+
+	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{
+		"emailAddress":              component.EmailAddress,
+		"from":                      component.From,
+		"smtpEndpoint":              component.SmtpEndpoint,
+		"smtpUsernameParameterName": component.SmtpUsernameParameterName,
+		"smtpPasswordParameterName": component.SmtpPasswordParameterName,
+		"dnsRecords":                component.DnsRecords,
+		"domainIdentityArn":         component.DomainIdentityArn,
+	}); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
+
+// mailDomainIdentity is the SES identity of the domain of a MailUser.
+type mailDomainIdentity struct {
+	arn pulumi.StringOutput
+	// The resources that have to exist before mail can be sent from the domain.
+	dependencies []pulumi.Resource
+	// The records to create in an external DNS provider, empty if DNS is managed.
+	dnsRecords pulumi.StringMapArrayOutput
+}
+
+// newMailDomainIdentity creates the SES domain identity with DKIM signing and verifies it through
+// the hosted zone of the domain.
+func newMailDomainIdentity(ctx *pulumi.Context, component pulumi.Resource, name string, args *MailUserArgs,
+	manageDns bool, sesOpts []pulumi.ResourceOption) (*mailDomainIdentity, error) {
+	domainIdentity, err := ses.NewDomainIdentity(ctx, name+"-identity", &ses.DomainIdentityArgs{
+		Domain: args.Domain,
+	}, sesOpts...)
+	if err != nil {
+		return nil, err
+	}
+	result := &mailDomainIdentity{
+		arn:        domainIdentity.Arn,
+		dnsRecords: pulumi.StringMapArray{}.ToStringMapArrayOutput(),
+	}
+	var hostedZoneId pulumi.StringInput
+	verificationDependencies := []pulumi.Resource{domainIdentity}
+	if manageDns {
+		hostedZoneId = resolveHostedZone(ctx, args.Domain, args.HostedZoneId)
+		verificationRecord, err := route53.NewRecord(ctx, name+"-verification-record", &route53.RecordArgs{
+			Name:           pulumi.Sprintf("_amazonses.%s", args.Domain),
+			Type:           pulumi.String("TXT"),
+			ZoneId:         hostedZoneId,
+			Ttl:            pulumi.Int(600),
+			AllowOverwrite: pulumi.Bool(true),
+			Records: pulumi.StringArray{
+				domainIdentity.VerificationToken,
+			},
+		}, pulumi.Parent(component))
+		if err != nil {
+			return nil, err
+		}
+		verificationDependencies = append(verificationDependencies, verificationRecord)
+	}
+	// Without managed DNS the verification would block the update until the records returned by it
+	// have been created, so SES is left to verify the identity once they exist.
+	if manageDns {
+		domainIdentityVerification, err := ses.NewDomainIdentityVerification(ctx, name+"-identity-verification", &ses.DomainIdentityVerificationArgs{
+			Domain: domainIdentity.Domain,
+		}, append(sesOpts, pulumi.DependsOn(verificationDependencies))...)
+		if err != nil {
+			return nil, err
+		}
+		result.dependencies = []pulumi.Resource{domainIdentityVerification}
+	}
+
+	// Sign outgoing mail with DKIM.
+	domainDkim, err := ses.NewDomainDkim(ctx, name+"-dkim", &ses.DomainDkimArgs{
+		Domain: domainIdentity.Domain,
+	}, sesOpts...)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; manageDns && i < sesDkimTokenCount; i++ {
+		token := domainDkim.DkimTokens.Index(pulumi.Int(i))
+		if _, err := route53.NewRecord(ctx, fmt.Sprintf("%s-dkim-record-%d", name, i), &route53.RecordArgs{
+			Name:           pulumi.Sprintf("%s._domainkey.%s", token, args.Domain),
+			Type:           pulumi.String("CNAME"),
+			ZoneId:         hostedZoneId,
+			Ttl:            pulumi.Int(600),
+			AllowOverwrite: pulumi.Bool(true),
+			Records: pulumi.StringArray{
+				pulumi.Sprintf("%s.dkim.amazonses.com", token),
+			},
+		}, pulumi.Parent(component)); err != nil {
+			return nil, err
+		}
+	}
+
+	if !manageDns {
+		// Return the records to create in the external DNS provider.
+		result.dnsRecords = pulumi.All(args.Domain, domainIdentity.VerificationToken, domainDkim.DkimTokens).ApplyT(
+			func(all []interface{}) []map[string]string {
+				domain, verificationToken, dkimTokens := all[0].(string), all[1].(string), all[2].([]string)
+				records := []map[string]string{
+					{"name": "_amazonses." + domain, "type": "TXT", "value": verificationToken},
+				}
+				for _, token := range dkimTokens {
+					records = append(records, map[string]string{
+						"name":  token + "._domainkey." + domain,
+						"type":  "CNAME",
+						"value": token + ".dkim.amazonses.com",
+					})
+				}
+				return records
+			}).(pulumi.StringMapArrayOutput)
+	}
+
+	return result, nil
+}
@@ -15,6 +15,7 @@
 package provider
 
 import (
+	"github.com/blang/semver"
 	"github.com/pkg/errors"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
@@ -29,11 +30,50 @@ func construct(ctx *pulumi.Context, typ, name string, inputs provider.ConstructI
 		return constructStaticPage(ctx, name, inputs, options)
 	case "gotiac:index:FileHosting":
 		return constructFileHosting(ctx, name, inputs, options)
+	case "gotiac:index:S3GraphStore":
+		return constructS3GraphStore(ctx, name, inputs, options)
+	case "gotiac:index:MailUser":
+		return constructMailUser(ctx, name, inputs, options)
 	default:
 		return nil, errors.Errorf("unknown resource type %s", typ)
 	}
 }
 
+func call(ctx *pulumi.Context, tok string, args provider.CallArgs) (*provider.CallResult, error) {
+	switch tok {
+	case "gotiac:index:FileHosting/signUrl":
+		return callFileHostingSignUrl(ctx, args)
+	case "gotiac:index:FileHosting/signCookies":
+		return callFileHostingSignCookies(ctx, args)
+	case "gotiac:index:FileHosting/invalidate":
+		return callFileHostingInvalidate(ctx, args)
+	default:
+		return nil, errors.Errorf("unknown method %s", tok)
+	}
+}
+
+// module rehydrates the component resources passed as `__self__` to methods, so that their
+// outputs can be read in the method implementations.
+type module struct {
+	version semver.Version
+}
+
+func (m *module) Version() semver.Version {
+	return m.version
+}
+
+func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi.Resource, err error) {
+	switch typ {
+	case "gotiac:index:FileHosting":
+		r = &FileHosting{}
+	default:
+		return nil, errors.Errorf("unknown resource type %s", typ)
+	}
+
+	err = ctx.RegisterResource(typ, name, nil, r, pulumi.URN_(urn))
+	return
+}
+
 // constructStaticPage is an implementation of Construct for the example StaticPage component.
 // It demonstrates converting the raw ConstructInputs to the component's args struct, creating
 // the component, and returning its URN and state (outputs).
@@ -80,3 +120,107 @@ func constructFileHosting(ctx *pulumi.Context, name string, inputs provider.Cons
 	// that is convertible to `pulumi.Input`.
 	return provider.NewConstructResult(fileHosting)
 }
+
+func constructS3GraphStore(ctx *pulumi.Context, name string, inputs provider.ConstructInputs,
+	options pulumi.ResourceOption) (*provider.ConstructResult, error) {
+
+	// Copy the raw inputs to S3GraphStoreArgs. `inputs.CopyTo` uses the types and `pulumi:` tags
+	// on the struct's fields to convert the raw values to the appropriate Input types.
+	args := &S3GraphStoreArgs{}
+	if err := inputs.CopyTo(args); err != nil {
+		return nil, errors.Wrap(err, "setting args")
+	}
+
+	// Create the component resource.
+	s3GraphStore, err := NewS3GraphStore(ctx, name, args, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating component")
+	}
+
+	// Return the component resource's URN and state. `NewConstructResult` automatically sets the
+	// ConstructResult's state based on resource struct fields tagged with `pulumi:` tags with a value
+	// that is convertible to `pulumi.Input`.
+	return provider.NewConstructResult(s3GraphStore)
+}
+
+func constructMailUser(ctx *pulumi.Context, name string, inputs provider.ConstructInputs,
+	options pulumi.ResourceOption) (*provider.ConstructResult, error) {
+
+	// Copy the raw inputs to MailUserArgs. `inputs.CopyTo` uses the types and `pulumi:` tags
+	// on the struct's fields to convert the raw values to the appropriate Input types.
+	args := &MailUserArgs{}
+	if err := inputs.CopyTo(args); err != nil {
+		return nil, errors.Wrap(err, "setting args")
+	}
+
+	// Create the component resource.
+	mailUser, err := NewMailUser(ctx, name, args, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating component")
+	}
+
+	// Return the component resource's URN and state. `NewConstructResult` automatically sets the
+	// ConstructResult's state based on resource struct fields tagged with `pulumi:` tags with a value
+	// that is convertible to `pulumi.Input`.
+	return provider.NewConstructResult(mailUser)
+}
+
+// callFileHostingSignUrl is an implementation of Call for the FileHosting signUrl method. It
+// copies the raw arguments to FileHostingSignArgs, rehydrates the FileHosting from `__self__` and
+// returns the method result.
+func callFileHostingSignUrl(ctx *pulumi.Context, args provider.CallArgs) (*provider.CallResult, error) {
+	methodArgs := &FileHostingSignArgs{}
+	res, err := args.CopyTo(methodArgs)
+	if err != nil {
+		return nil, errors.Wrap(err, "setting args")
+	}
+	fileHosting, ok := res.(*FileHosting)
+	if !ok {
+		return nil, errors.Errorf("expected __self__ to be a FileHosting, got %T", res)
+	}
+
+	result, err := fileHosting.SignUrl(ctx, methodArgs)
+	if err != nil {
+		return nil, errors.Wrap(err, "calling method")
+	}
+
+	return provider.NewCallResult(result)
+}
+
+func callFileHostingSignCookies(ctx *pulumi.Context, args provider.CallArgs) (*provider.CallResult, error) {
+	methodArgs := &FileHostingSignArgs{}
+	res, err := args.CopyTo(methodArgs)
+	if err != nil {
+		return nil, errors.Wrap(err, "setting args")
+	}
+	fileHosting, ok := res.(*FileHosting)
+	if !ok {
+		return nil, errors.Errorf("expected __self__ to be a FileHosting, got %T", res)
+	}
+
+	result, err := fileHosting.SignCookies(ctx, methodArgs)
+	if err != nil {
+		return nil, errors.Wrap(err, "calling method")
+	}
+
+	return provider.NewCallResult(result)
+}
+
+func callFileHostingInvalidate(ctx *pulumi.Context, args provider.CallArgs) (*provider.CallResult, error) {
+	methodArgs := &FileHostingInvalidateArgs{}
+	res, err := args.CopyTo(methodArgs)
+	if err != nil {
+		return nil, errors.Wrap(err, "setting args")
+	}
+	fileHosting, ok := res.(*FileHosting)
+	if !ok {
+		return nil, errors.Errorf("expected __self__ to be a FileHosting, got %T", res)
+	}
+
+	result, err := fileHosting.Invalidate(ctx, methodArgs)
+	if err != nil {
+		return nil, errors.Wrap(err, "calling method")
+	}
+
+	return provider.NewCallResult(result)
+}
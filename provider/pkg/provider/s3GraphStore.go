@@ -1 +1,250 @@
 package provider
+
+import (
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/iam"
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/s3"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The key prefixes that make up the gothub graph store layout.
+var graphStorePrefixes = []string{"nodes", "edges", "props"}
+
+// The set of arguments for creating a S3GraphStore component resource.
+type S3GraphStoreArgs struct {
+	// The name of the bucket to create. If not provided, a unique name will be generated.
+	BucketName *pulumi.StringInput `pulumi:"bucketName"`
+	// The ARN of a KMS key used to encrypt the graph. If not provided, objects are encrypted
+	// with S3 managed keys.
+	KmsKeyArn *pulumi.StringInput `pulumi:"kmsKeyArn"`
+	// The number of days noncurrent object versions are kept. Defaults to 30.
+	NoncurrentVersionExpirationDays *pulumi.IntInput `pulumi:"noncurrentVersionExpirationDays"`
+	// Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+	ForceDestroy *pulumi.BoolInput `pulumi:"forceDestroy"`
+}
+
+// The S3GraphStore component resource.
+type S3GraphStore struct {
+	pulumi.ResourceState
+
+	BucketName    pulumi.StringOutput `pulumi:"bucketName"`
+	BucketArn     pulumi.StringOutput `pulumi:"bucketArn"`
+	ReaderRoleArn pulumi.StringOutput `pulumi:"readerRoleArn"`
+	WriterRoleArn pulumi.StringOutput `pulumi:"writerRoleArn"`
+}
+
+// NewS3GraphStore creates a new S3GraphStore component resource.
+func NewS3GraphStore(ctx *pulumi.Context,
+	name string, args *S3GraphStoreArgs, opts ...pulumi.ResourceOption) (*S3GraphStore, error) {
+	if args == nil {
+		args = &S3GraphStoreArgs{}
+	}
+
+	component := &S3GraphStore{}
+	err := ctx.RegisterComponentResource("gotiac:index:S3GraphStore", name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketArgs := &s3.BucketArgs{}
+	if args.BucketName != nil {
+		bucketArgs.Bucket = *args.BucketName
+	}
+	if args.ForceDestroy != nil {
+		bucketArgs.ForceDestroy = *args.ForceDestroy
+	}
+	// Create the bucket holding the graph.
+	bucket, err := s3.NewBucket(ctx, name, bucketArgs, pulumi.Parent(component))
+	if err != nil {
+		return nil, err
+	}
+
+	// Block all public access, the graph is only reachable through the access roles.
+	if _, err := s3.NewBucketPublicAccessBlock(ctx, name+"-public-access-block", &s3.BucketPublicAccessBlockArgs{
+		Bucket:                bucket.ID(),
+		BlockPublicPolicy:     pulumi.Bool(true),
+		BlockPublicAcls:       pulumi.Bool(true),
+		IgnorePublicAcls:      pulumi.Bool(true),
+		RestrictPublicBuckets: pulumi.Bool(true),
+	}, pulumi.Parent(bucket)); err != nil {
+		return nil, err
+	}
+
+	if _, err := s3.NewBucketOwnershipControls(ctx, name+"-ownership-controls", &s3.BucketOwnershipControlsArgs{
+		Bucket: bucket.ID(),
+		Rule: &s3.BucketOwnershipControlsRuleArgs{
+			ObjectOwnership: pulumi.String("BucketOwnerEnforced"),
+		},
+	}, pulumi.Parent(bucket)); err != nil {
+		return nil, err
+	}
+
+	// Keep previous versions of nodes, edges and props so that overwrites can be recovered.
+	versioning, err := s3.NewBucketVersioningV2(ctx, name+"-versioning", &s3.BucketVersioningV2Args{
+		Bucket: bucket.ID(),
+		VersioningConfiguration: &s3.BucketVersioningV2VersioningConfigurationArgs{
+			Status: pulumi.String("Enabled"),
+		},
+	}, pulumi.Parent(bucket))
+	if err != nil {
+		return nil, err
+	}
+
+	// Encrypt the graph at rest, either with the given KMS key or with S3 managed keys.
+	encryptionByDefault := &s3.BucketServerSideEncryptionConfigurationV2RuleApplyServerSideEncryptionByDefaultArgs{
+		SseAlgorithm: pulumi.String("AES256"),
+	}
+	if args.KmsKeyArn != nil {
+		encryptionByDefault = &s3.BucketServerSideEncryptionConfigurationV2RuleApplyServerSideEncryptionByDefaultArgs{
+			SseAlgorithm:   pulumi.String("aws:kms"),
+			KmsMasterKeyId: *args.KmsKeyArn,
+		}
+	}
+	if _, err := s3.NewBucketServerSideEncryptionConfigurationV2(ctx, name+"-encryption", &s3.BucketServerSideEncryptionConfigurationV2Args{
+		Bucket: bucket.ID(),
+		Rules: s3.BucketServerSideEncryptionConfigurationV2RuleArray{
+			&s3.BucketServerSideEncryptionConfigurationV2RuleArgs{
+				ApplyServerSideEncryptionByDefault: encryptionByDefault,
+				BucketKeyEnabled:                   pulumi.Bool(args.KmsKeyArn != nil),
+			},
+		},
+	}, pulumi.Parent(bucket)); err != nil {
+		return nil, err
+	}
+
+	// Expire noncurrent versions and clean up incomplete multipart uploads.
+	var noncurrentVersionExpirationDays pulumi.IntInput = pulumi.Int(30)
+	if args.NoncurrentVersionExpirationDays != nil {
+		noncurrentVersionExpirationDays = *args.NoncurrentVersionExpirationDays
+	}
+	if _, err := s3.NewBucketLifecycleConfigurationV2(ctx, name+"-lifecycle", &s3.BucketLifecycleConfigurationV2Args{
+		Bucket: bucket.ID(),
+		Rules: s3.BucketLifecycleConfigurationV2RuleArray{
+			&s3.BucketLifecycleConfigurationV2RuleArgs{
+				Id:     pulumi.String("noncurrent-version-expiration"),
+				Status: pulumi.String("Enabled"),
+				Filter: &s3.BucketLifecycleConfigurationV2RuleFilterArgs{},
+				NoncurrentVersionExpiration: &s3.BucketLifecycleConfigurationV2RuleNoncurrentVersionExpirationArgs{
+					NoncurrentDays: noncurrentVersionExpirationDays,
+				},
+				AbortIncompleteMultipartUpload: &s3.BucketLifecycleConfigurationV2RuleAbortIncompleteMultipartUploadArgs{
+					DaysAfterInitiation: pulumi.Int(7),
+				},
+			},
+		},
+	}, pulumi.Parent(bucket), pulumi.DependsOn([]pulumi.Resource{versioning})); err != nil {
+		return nil, err
+	}
+
+	// Create a folder object for each prefix of the graph layout.
+	objectResources := []interface{}{}
+	listPrefixes := []interface{}{}
+	for _, prefix := range graphStorePrefixes {
+		if _, err := s3.NewBucketObjectv2(ctx, name+"-"+prefix, &s3.BucketObjectv2Args{
+			Bucket:  bucket.ID(),
+			Key:     pulumi.String(prefix + "/"),
+			Content: pulumi.String(""),
+		}, pulumi.Parent(bucket)); err != nil {
+			return nil, err
+		}
+		objectResources = append(objectResources, pulumi.Sprintf("%s/%s/*", bucket.Arn, prefix))
+		listPrefixes = append(listPrefixes, prefix+"/*")
+	}
+
+	// Create the roles the graph API uses to access the bucket.
+	readerActions := []interface{}{
+		"s3:GetObject",
+		"s3:GetObjectVersion",
+	}
+	readerRole, err := newGraphStoreRole(ctx, name+"-reader", component, bucket, args.KmsKeyArn,
+		readerActions, objectResources, listPrefixes, []interface{}{"kms:Decrypt"})
+	if err != nil {
+		return nil, err
+	}
+	writerActions := []interface{}{
+		"s3:GetObject",
+		"s3:GetObjectVersion",
+		"s3:PutObject",
+		"s3:DeleteObject",
+		"s3:AbortMultipartUpload",
+	}
+	writerRole, err := newGraphStoreRole(ctx, name+"-writer", component, bucket, args.KmsKeyArn,
+		writerActions, objectResources, listPrefixes, []interface{}{"kms:Decrypt", "kms:GenerateDataKey"})
+	if err != nil {
+		return nil, err
+	}
+
+	component.BucketName = bucket.Bucket
+	component.BucketArn = bucket.Arn
+	component.ReaderRoleArn = readerRole.Arn
+	component.WriterRoleArn = writerRole.Arn
+
+	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{
+		"bucketName":    component.BucketName,
+		"bucketArn":     component.BucketArn,
+		"readerRoleArn": component.ReaderRoleArn,
+		"writerRoleArn": component.WriterRoleArn,
+	}); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
+
+// newGraphStoreRole creates a role that Lambda functions can assume to perform the given actions on
+// the graph prefixes of the bucket.
+func newGraphStoreRole(ctx *pulumi.Context, name string, parent pulumi.Resource, bucket *s3.Bucket,
+	kmsKeyArn *pulumi.StringInput, actions, objectResources, listPrefixes, kmsActions []interface{}) (*iam.Role, error) {
+	role, err := iam.NewRole(ctx, name, &iam.RoleArgs{
+		AssumeRolePolicy: pulumi.Any(map[string]interface{}{
+			"Version": "2012-10-17",
+			"Statement": []map[string]interface{}{
+				{
+					"Effect": "Allow",
+					"Principal": map[string]interface{}{
+						"Service": "lambda.amazonaws.com",
+					},
+					"Action": "sts:AssumeRole",
+				},
+			},
+		}),
+	}, pulumi.Parent(parent))
+	if err != nil {
+		return nil, err
+	}
+
+	statements := []map[string]interface{}{
+		{
+			"Effect":   "Allow",
+			"Action":   []interface{}{"s3:ListBucket"},
+			"Resource": []interface{}{bucket.Arn},
+			"Condition": map[string]interface{}{
+				"StringLike": map[string]interface{}{
+					"s3:prefix": listPrefixes,
+				},
+			},
+		},
+		{
+			"Effect":   "Allow",
+			"Action":   actions,
+			"Resource": objectResources,
+		},
+	}
+	if kmsKeyArn != nil {
+		statements = append(statements, map[string]interface{}{
+			"Effect":   "Allow",
+			"Action":   kmsActions,
+			"Resource": []interface{}{*kmsKeyArn},
+		})
+	}
+	if _, err := iam.NewRolePolicy(ctx, name, &iam.RolePolicyArgs{
+		Role: role.ID(),
+		Policy: pulumi.Any(map[string]interface{}{
+			"Version":   "2012-10-17",
+			"Statement": statements,
+		}),
+	}, pulumi.Parent(role)); err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
@@ -15,14 +15,29 @@
 package provider
 
 import (
+	"github.com/blang/semver"
 	"github.com/pulumi/pulumi/pkg/v3/resource/provider"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
 // Serve launches the gRPC server for the resource provider.
 func Serve(providerName, version string, schema []byte) {
+	// Register the resource module used to rehydrate components passed to methods.
+	moduleVersion, err := semver.ParseTolerant(version)
+	if err != nil {
+		cmdutil.ExitError(err.Error())
+	}
+	pulumi.RegisterResourceModule(providerName, "index", &module{version: moduleVersion})
+
 	// Start gRPC service.
-	if err := provider.ComponentMain(providerName, version, schema, construct); err != nil {
+	if err := provider.MainWithOptions(provider.Options{
+		Name:      providerName,
+		Version:   version,
+		Schema:    schema,
+		Construct: construct,
+		Call:      call,
+	}); err != nil {
 		cmdutil.ExitError(err.Error())
 	}
 }
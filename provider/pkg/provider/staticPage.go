@@ -57,10 +57,10 @@ func NewStaticPage(ctx *pulumi.Context,
 	}
 
 	// Creat public access block configuration to block public access to the bucket.
-	if _, err := s3.NewBucketPublicAccessBlock(ctx, "bucketPublicAccessBlock", &s3.BucketPublicAccessBlockArgs{
+	if _, err := s3.NewBucketPublicAccessBlock(ctx, name+"-public-access-block", &s3.BucketPublicAccessBlockArgs{
 		Bucket:            bucket.ID(),
 		BlockPublicPolicy: pulumi.Bool(false),
-	}, pulumi.Parent(bucket)); err != nil {
+	}, pulumi.Parent(bucket), pulumi.Aliases([]pulumi.Alias{{Name: pulumi.String("bucketPublicAccessBlock")}})); err != nil {
 		return nil, err
 	}
 
@@ -75,7 +75,7 @@ func NewStaticPage(ctx *pulumi.Context,
 	}
 
 	// Set the access policy for the bucket so all objects are readable.
-	if _, err := s3.NewBucketPolicy(ctx, "bucketPolicy", &s3.BucketPolicyArgs{
+	if _, err := s3.NewBucketPolicy(ctx, name+"-bucket-policy", &s3.BucketPolicyArgs{
 		Bucket: bucket.ID(),
 		Policy: pulumi.Any(map[string]interface{}{
 			"Version": "2012-10-17",
@@ -92,7 +92,7 @@ func NewStaticPage(ctx *pulumi.Context,
 				},
 			},
 		}),
-	}, pulumi.Parent(bucket)); err != nil {
+	}, pulumi.Parent(bucket), pulumi.Aliases([]pulumi.Alias{{Name: pulumi.String("bucketPolicy")}})); err != nil {
 		return nil, err
 	}
 
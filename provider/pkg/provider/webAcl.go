@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi-aws/sdk/v6/go/aws/wafv2"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The AWS managed rule groups a web ACL uses by default. The common rule set is left out since it
+// blocks request bodies larger than 8 KB, which would break uploads.
+var defaultManagedRuleGroups = []string{
+	"AWSManagedRulesAmazonIpReputationList",
+	"AWSManagedRulesKnownBadInputsRuleSet",
+}
+
+// The settings of a WAF web ACL created for a CloudFront distribution.
+type WebAclArgs struct {
+	// The maximum number of requests a single IP address may make in 5 minutes before it is
+	// blocked. Defaults to 2000, 0 disables rate limiting.
+	RateLimit *int `pulumi:"rateLimit"`
+	// The names of the AWS managed rule groups to apply. Defaults to
+	// AWSManagedRulesAmazonIpReputationList and AWSManagedRulesKnownBadInputsRuleSet.
+	ManagedRuleGroups []string `pulumi:"managedRuleGroups"`
+	// The IP addresses and CIDR ranges that are allowed. If provided, all other requests are
+	// blocked.
+	AllowedIpAddresses []string `pulumi:"allowedIpAddresses"`
+	// The IP addresses and CIDR ranges that are blocked.
+	BlockedIpAddresses []string `pulumi:"blockedIpAddresses"`
+}
+
+// newWebAcl creates a web ACL for CloudFront. Blocked addresses are evaluated first, then the
+// rate limit and the managed rule groups and finally the allowed addresses. The provider has to
+// be in us-east-1, where CloudFront web ACLs live.
+func newWebAcl(ctx *pulumi.Context, name string, args *WebAclArgs, opts ...pulumi.ResourceOption) (*wafv2.WebAcl, error) {
+	rules := wafv2.WebAclRuleArray{}
+
+	blockedIpSets, err := newWebAclIpSets(ctx, name+"-blocked", args.BlockedIpAddresses, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, ipSet := range blockedIpSets {
+		rules = append(rules, webAclIpSetRule(ipSet, len(rules), &wafv2.WebAclRuleActionArgs{
+			Block: &wafv2.WebAclRuleActionBlockArgs{},
+		}))
+	}
+
+	rateLimit := 2000
+	if args.RateLimit != nil {
+		rateLimit = *args.RateLimit
+	}
+	if rateLimit > 0 {
+		rules = append(rules, &wafv2.WebAclRuleArgs{
+			Name:     pulumi.String("rate-limit"),
+			Priority: pulumi.Int(len(rules)),
+			Action: &wafv2.WebAclRuleActionArgs{
+				Block: &wafv2.WebAclRuleActionBlockArgs{},
+			},
+			Statement: &wafv2.WebAclRuleStatementArgs{
+				RateBasedStatement: &wafv2.WebAclRuleStatementRateBasedStatementArgs{
+					Limit:            pulumi.Int(rateLimit),
+					AggregateKeyType: pulumi.String("IP"),
+				},
+			},
+			VisibilityConfig: webAclRuleVisibilityConfig("rate-limit"),
+		})
+	}
+
+	managedRuleGroups := defaultManagedRuleGroups
+	if args.ManagedRuleGroups != nil {
+		managedRuleGroups = args.ManagedRuleGroups
+	}
+	for _, ruleGroup := range managedRuleGroups {
+		rules = append(rules, &wafv2.WebAclRuleArgs{
+			Name:     pulumi.String(ruleGroup),
+			Priority: pulumi.Int(len(rules)),
+			OverrideAction: &wafv2.WebAclRuleOverrideActionArgs{
+				None: &wafv2.WebAclRuleOverrideActionNoneArgs{},
+			},
+			Statement: &wafv2.WebAclRuleStatementArgs{
+				ManagedRuleGroupStatement: &wafv2.WebAclRuleStatementManagedRuleGroupStatementArgs{
+					Name:       pulumi.String(ruleGroup),
+					VendorName: pulumi.String("AWS"),
+				},
+			},
+			VisibilityConfig: webAclRuleVisibilityConfig(ruleGroup),
+		})
+	}
+
+	// With an allow list, everything that is not explicitly allowed is blocked.
+	defaultAction := &wafv2.WebAclDefaultActionArgs{
+		Allow: &wafv2.WebAclDefaultActionAllowArgs{},
+	}
+	if len(args.AllowedIpAddresses) > 0 {
+		allowedIpSets, err := newWebAclIpSets(ctx, name+"-allowed", args.AllowedIpAddresses, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, ipSet := range allowedIpSets {
+			rules = append(rules, webAclIpSetRule(ipSet, len(rules), &wafv2.WebAclRuleActionArgs{
+				Allow: &wafv2.WebAclRuleActionAllowArgs{},
+			}))
+		}
+		defaultAction = &wafv2.WebAclDefaultActionArgs{
+			Block: &wafv2.WebAclDefaultActionBlockArgs{},
+		}
+	}
+
+	return wafv2.NewWebAcl(ctx, name, &wafv2.WebAclArgs{
+		Scope:         pulumi.String("CLOUDFRONT"),
+		DefaultAction: defaultAction,
+		Rules:         rules,
+		VisibilityConfig: &wafv2.WebAclVisibilityConfigArgs{
+			CloudwatchMetricsEnabled: pulumi.Bool(true),
+			MetricName:               pulumi.String("gotiac-web-acl"),
+			SampledRequestsEnabled:   pulumi.Bool(true),
+		},
+	}, opts...)
+}
+
+// webAclIpSet is an IP set of a web ACL together with the name of its rule.
+type webAclIpSet struct {
+	ruleName string
+	ipSet    *wafv2.IpSet
+}
+
+// newWebAclIpSets creates an IP set per IP version of the addresses, as WAF does not mix IPv4 and
+// IPv6 addresses in one set.
+func newWebAclIpSets(ctx *pulumi.Context, name string, addresses []string, opts ...pulumi.ResourceOption) ([]webAclIpSet, error) {
+	addressesByVersion := map[string][]string{}
+	for _, address := range addresses {
+		if strings.Contains(address, ":") {
+			addressesByVersion["IPV6"] = append(addressesByVersion["IPV6"], address)
+		} else {
+			addressesByVersion["IPV4"] = append(addressesByVersion["IPV4"], address)
+		}
+	}
+
+	ipSets := []webAclIpSet{}
+	for _, version := range []string{"IPV4", "IPV6"} {
+		if len(addressesByVersion[version]) == 0 {
+			continue
+		}
+		ipSetName := fmt.Sprintf("%s-%s", name, strings.ToLower(version))
+		ipSet, err := wafv2.NewIpSet(ctx, ipSetName, &wafv2.IpSetArgs{
+			Scope:            pulumi.String("CLOUDFRONT"),
+			IpAddressVersion: pulumi.String(version),
+			Addresses:        pulumi.ToStringArray(addressesByVersion[version]),
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		ipSets = append(ipSets, webAclIpSet{ruleName: ipSetName, ipSet: ipSet})
+	}
+	return ipSets, nil
+}
+
+// webAclIpSetRule returns a rule applying the action to requests from the IP set.
+func webAclIpSetRule(ipSet webAclIpSet, priority int, action *wafv2.WebAclRuleActionArgs) *wafv2.WebAclRuleArgs {
+	return &wafv2.WebAclRuleArgs{
+		Name:     pulumi.String(ipSet.ruleName),
+		Priority: pulumi.Int(priority),
+		Action:   action,
+		Statement: &wafv2.WebAclRuleStatementArgs{
+			IpSetReferenceStatement: &wafv2.WebAclRuleStatementIpSetReferenceStatementArgs{
+				Arn: ipSet.ipSet.Arn,
+			},
+		},
+		VisibilityConfig: webAclRuleVisibilityConfig(ipSet.ruleName),
+	}
+}
+
+// webAclRuleVisibilityConfig returns the metrics settings of a web ACL rule.
+func webAclRuleVisibilityConfig(metricName string) *wafv2.WebAclRuleVisibilityConfigArgs {
+	return &wafv2.WebAclRuleVisibilityConfigArgs{
+		CloudwatchMetricsEnabled: pulumi.Bool(true),
+		MetricName:               pulumi.String(metricName),
+		SampledRequestsEnabled:   pulumi.Bool(true),
+	}
+}
@@ -0,0 +1,234 @@
+// Package signer creates CloudFront signed URLs and signed cookies with the keys of a trusted key
+// group.
+package signer
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The names of the cookies CloudFront reads signatures from.
+const (
+	CookiePolicy    = "CloudFront-Policy"
+	CookieSignature = "CloudFront-Signature"
+	CookieKeyPairId = "CloudFront-Key-Pair-Id"
+	CookieExpires   = "CloudFront-Expires"
+)
+
+// Signer signs URLs and cookies with a private key whose public key is part of the trusted key
+// group of a distribution.
+type Signer struct {
+	keyId string
+	key   *rsa.PrivateKey
+}
+
+// New creates a Signer for the CloudFront public key with the given ID from the PEM encoded RSA
+// private key.
+func New(keyId string, privateKeyPem string) (*Signer, error) {
+	block, _ := pem.Decode([]byte(privateKeyPem))
+	if block == nil {
+		return nil, errors.New("no PEM data found in private key")
+	}
+
+	var key *rsa.PrivateKey
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing private key")
+		}
+		key = parsed
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing private key")
+		}
+		rsaKey, ok := parsed.(*rsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("private key is not an RSA key")
+		}
+		key = rsaKey
+	default:
+		return nil, errors.Errorf("unsupported private key type %q", block.Type)
+	}
+
+	return &Signer{keyId: keyId, key: key}, nil
+}
+
+// Policy restricts access to a resource. A policy that only sets Resource and Expires can be used
+// as a canned policy, all other conditions require a custom policy.
+type Policy struct {
+	// The URL the policy applies to. Custom policies may use * wildcards.
+	Resource string
+	// The time after which access is denied.
+	Expires time.Time
+	// The time before which access is denied. Optional.
+	Starts time.Time
+	// The IP address or CIDR range access is restricted to. Optional.
+	IpAddress string
+}
+
+// IsCanned reports whether the policy can be expressed as a canned policy.
+func (p Policy) IsCanned() bool {
+	return p.Starts.IsZero() && p.IpAddress == "" && !strings.Contains(p.Resource, "*")
+}
+
+type epochTime struct {
+	EpochTime int64 `json:"AWS:EpochTime"`
+}
+
+type sourceIp struct {
+	SourceIp string `json:"AWS:SourceIp"`
+}
+
+type condition struct {
+	DateLessThan    epochTime  `json:"DateLessThan"`
+	DateGreaterThan *epochTime `json:"DateGreaterThan,omitempty"`
+	IpAddress       *sourceIp  `json:"IpAddress,omitempty"`
+}
+
+type statement struct {
+	Resource  string    `json:"Resource"`
+	Condition condition `json:"Condition"`
+}
+
+type policyDocument struct {
+	Statement []statement `json:"Statement"`
+}
+
+// document returns the JSON policy document. For canned policies CloudFront rebuilds the document
+// itself, so the encoding has to match its format exactly: no whitespace and no HTML escaping.
+func (p Policy) document() ([]byte, error) {
+	if p.Resource == "" {
+		return nil, errors.New("policy resource must not be empty")
+	}
+	if p.Expires.IsZero() {
+		return nil, errors.New("policy expiry must be set")
+	}
+
+	c := condition{DateLessThan: epochTime{p.Expires.Unix()}}
+	if !p.Starts.IsZero() {
+		if !p.Starts.Before(p.Expires) {
+			return nil, errors.New("policy start must be before its expiry")
+		}
+		c.DateGreaterThan = &epochTime{p.Starts.Unix()}
+	}
+	if p.IpAddress != "" {
+		c.IpAddress = &sourceIp{p.IpAddress}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(policyDocument{Statement: []statement{{Resource: p.Resource, Condition: c}}}); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// sign returns the URL safe signature of the policy document.
+func (s *Signer) sign(document []byte) (string, error) {
+	hash := sha1.Sum(document)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA1, hash[:])
+	if err != nil {
+		return "", errors.Wrap(err, "signing policy")
+	}
+	return encode(signature), nil
+}
+
+// ObjectUrl returns the URL of the object with the key below the base URL, escaped the way it is
+// requested. Each segment of the key is escaped on its own, so # and ? in a key are part of the
+// path, while * stays literal as the wildcard of custom policies.
+func ObjectUrl(baseUrl string, key string) string {
+	segments := strings.Split(strings.TrimPrefix(key, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(url.PathEscape(segment), "%2A", "*")
+	}
+	return strings.TrimSuffix(baseUrl, "/") + "/" + strings.Join(segments, "/")
+}
+
+// SignUrl signs the URL with a canned policy that expires at the given time. The URL has to be
+// escaped, e.g. by ObjectUrl, as CloudFront compares the policy with the requested URL.
+func (s *Signer) SignUrl(rawUrl string, expires time.Time) (string, error) {
+	return s.SignUrlWithPolicy(rawUrl, Policy{Resource: rawUrl, Expires: expires})
+}
+
+// SignUrlWithPolicy signs the escaped URL with the given policy. A canned policy is used if the
+// policy allows it, otherwise the custom policy is added to the URL.
+func (s *Signer) SignUrlWithPolicy(rawUrl string, policy Policy) (string, error) {
+	if _, err := url.Parse(rawUrl); err != nil {
+		return "", errors.Wrap(err, "parsing url")
+	}
+	document, err := policy.document()
+	if err != nil {
+		return "", err
+	}
+	signature, err := s.sign(document)
+	if err != nil {
+		return "", err
+	}
+
+	// CloudFront expects the signature parameters at the end of the query string. The URL is kept
+	// as is, as escaping it again would change the resource the policy was signed for.
+	params := []string{}
+	if policy.IsCanned() && policy.Resource == rawUrl {
+		params = append(params, "Expires="+strconv.FormatInt(policy.Expires.Unix(), 10))
+	} else {
+		params = append(params, "Policy="+encode(document))
+	}
+	params = append(params, "Signature="+signature, "Key-Pair-Id="+s.keyId)
+	separator := "?"
+	if strings.Contains(rawUrl, "?") {
+		separator = "&"
+	}
+
+	return rawUrl + separator + strings.Join(params, "&"), nil
+}
+
+// SignCookies returns the cookies granting access to the resource with a canned policy that
+// expires at the given time.
+func (s *Signer) SignCookies(resource string, expires time.Time) (map[string]string, error) {
+	return s.SignCookiesWithPolicy(Policy{Resource: resource, Expires: expires})
+}
+
+// SignCookiesWithPolicy returns the cookies granting access according to the given policy. A
+// canned policy is used if the policy allows it.
+func (s *Signer) SignCookiesWithPolicy(policy Policy) (map[string]string, error) {
+	document, err := policy.document()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := s.sign(document)
+	if err != nil {
+		return nil, err
+	}
+
+	cookies := map[string]string{
+		CookieSignature: signature,
+		CookieKeyPairId: s.keyId,
+	}
+	if policy.IsCanned() {
+		cookies[CookieExpires] = strconv.FormatInt(policy.Expires.Unix(), 10)
+	} else {
+		cookies[CookiePolicy] = encode(document)
+	}
+	return cookies, nil
+}
+
+// encode returns the CloudFront flavour of URL safe base64.
+func encode(data []byte) string {
+	return strings.NewReplacer("+", "-", "=", "_", "/", "~").Replace(base64.StdEncoding.EncodeToString(data))
+}
@@ -0,0 +1,240 @@
+package signer
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testKeyId = "K2JCJMDEHXQW5F"
+
+var testExpires = time.Unix(1700000000, 0)
+
+func newTestSigner(t *testing.T) (*Signer, *rsa.PublicKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKeyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	s, err := New(testKeyId, string(privateKeyPem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, &key.PublicKey
+}
+
+// decode reverses encode.
+func decode(t *testing.T, encoded string) []byte {
+	t.Helper()
+	data, err := base64.StdEncoding.DecodeString(strings.NewReplacer("-", "+", "_", "=", "~", "/").Replace(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// verify checks the signature of the policy document with the public key.
+func verify(t *testing.T, publicKey *rsa.PublicKey, document []byte, signature string) {
+	t.Helper()
+	hash := sha1.Sum(document)
+	if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA1, hash[:], decode(t, signature)); err != nil {
+		t.Fatalf("invalid signature of %s: %v", document, err)
+	}
+}
+
+func cannedDocument(resource string) []byte {
+	return []byte(`{"Statement":[{"Resource":"` + resource + `","Condition":{"DateLessThan":{"AWS:EpochTime":` +
+		strconv.FormatInt(testExpires.Unix(), 10) + `}}}]}`)
+}
+
+func TestEncode(t *testing.T) {
+	// 0xfb 0xff encodes to "+/8=" in standard base64.
+	if encoded := encode([]byte{0xfb, 0xff}); encoded != "-~8_" {
+		t.Fatalf("expected -~8_, got %s", encoded)
+	}
+}
+
+func TestObjectUrl(t *testing.T) {
+	for key, expected := range map[string]string{
+		"foo.jpg":           "https://files.example.com/foo.jpg",
+		"/foo bär.jpg":      "https://files.example.com/foo%20b%C3%A4r.jpg",
+		"a b/*":             "https://files.example.com/a%20b/*",
+		"a b/*.jpg":         "https://files.example.com/a%20b/*.jpg",
+		"f#1?.jpg":          "https://files.example.com/f%231%3F.jpg",
+		"dir/sub dir/x.jpg": "https://files.example.com/dir/sub%20dir/x.jpg",
+	} {
+		if objectUrl := ObjectUrl("https://files.example.com", key); objectUrl != expected {
+			t.Errorf("expected %s for key %q, got %s", expected, key, objectUrl)
+		}
+	}
+}
+
+func TestSignUrlCanned(t *testing.T) {
+	s, publicKey := newTestSigner(t)
+
+	resource := ObjectUrl("https://files.example.com", "foo bär.jpg")
+	signed, err := s.SignUrl(resource, testExpires)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(signed, "https://files.example.com/foo%20b%C3%A4r.jpg?") {
+		t.Fatalf("expected the escaped URL, got %s", signed)
+	}
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if query.Has("Policy") {
+		t.Fatal("expected a canned policy")
+	}
+	if expires := query.Get("Expires"); expires != strconv.FormatInt(testExpires.Unix(), 10) {
+		t.Fatalf("unexpected Expires %s", expires)
+	}
+	if keyPairId := query.Get("Key-Pair-Id"); keyPairId != testKeyId {
+		t.Fatalf("unexpected Key-Pair-Id %s", keyPairId)
+	}
+	verify(t, publicKey, cannedDocument(resource), query.Get("Signature"))
+}
+
+func TestSignUrlReservedCharacters(t *testing.T) {
+	s, publicKey := newTestSigner(t)
+
+	resource := ObjectUrl("https://files.example.com", "report #1?.pdf")
+	signed, err := s.SignUrl(resource, testExpires)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Path != "/report #1?.pdf" || u.Fragment != "" {
+		t.Fatalf("expected # and ? to be part of the path, got path %q and fragment %q", u.Path, u.Fragment)
+	}
+	verify(t, publicKey, cannedDocument(resource), u.Query().Get("Signature"))
+}
+
+func TestSignUrlKeepsQuery(t *testing.T) {
+	s, _ := newTestSigner(t)
+
+	signed, err := s.SignUrl("https://files.example.com/foo.jpg?size=large", testExpires)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(signed, "https://files.example.com/foo.jpg?size=large&Expires=") {
+		t.Fatalf("expected the signature parameters after the query, got %s", signed)
+	}
+}
+
+func TestSignUrlCustom(t *testing.T) {
+	s, publicKey := newTestSigner(t)
+
+	signed, err := s.SignUrlWithPolicy("https://files.example.com/foo.jpg", Policy{
+		Resource:  "https://files.example.com/foo.jpg",
+		Expires:   testExpires,
+		IpAddress: "192.0.2.0/24",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if query.Has("Expires") {
+		t.Fatal("expected a custom policy")
+	}
+	document := decode(t, query.Get("Policy"))
+	if !strings.Contains(string(document), `"IpAddress":{"AWS:SourceIp":"192.0.2.0/24"}`) {
+		t.Fatalf("expected the IP address condition, got %s", document)
+	}
+	verify(t, publicKey, document, query.Get("Signature"))
+}
+
+func TestSignUrlWildcard(t *testing.T) {
+	s, publicKey := newTestSigner(t)
+
+	signed, err := s.SignUrlWithPolicy(ObjectUrl("https://files.example.com", "a b/c.jpg"), Policy{
+		Resource: ObjectUrl("https://files.example.com", "a b/*"),
+		Expires:  testExpires,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(signed, "https://files.example.com/a%20b/c.jpg?Policy=") {
+		t.Fatalf("expected a custom policy for a wildcard resource, got %s", signed)
+	}
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	document := decode(t, u.Query().Get("Policy"))
+	if !strings.Contains(string(document), `"Resource":"https://files.example.com/a%20b/*"`) {
+		t.Fatalf("expected the wildcard to stay literal, got %s", document)
+	}
+	verify(t, publicKey, document, u.Query().Get("Signature"))
+}
+
+func TestSignCookiesCanned(t *testing.T) {
+	s, publicKey := newTestSigner(t)
+
+	cookies, err := s.SignCookies("https://files.example.com/foo.jpg", testExpires)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cookies) != 3 {
+		t.Fatalf("expected 3 cookies, got %v", cookies)
+	}
+	if cookies[CookieExpires] != strconv.FormatInt(testExpires.Unix(), 10) {
+		t.Fatalf("unexpected %s %s", CookieExpires, cookies[CookieExpires])
+	}
+	if cookies[CookieKeyPairId] != testKeyId {
+		t.Fatalf("unexpected %s %s", CookieKeyPairId, cookies[CookieKeyPairId])
+	}
+	verify(t, publicKey, cannedDocument("https://files.example.com/foo.jpg"), cookies[CookieSignature])
+}
+
+func TestSignCookiesCustom(t *testing.T) {
+	s, publicKey := newTestSigner(t)
+
+	cookies, err := s.SignCookiesWithPolicy(Policy{
+		Resource: "https://files.example.com/*",
+		Expires:  testExpires,
+		Starts:   testExpires.Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cookies) != 3 {
+		t.Fatalf("expected 3 cookies, got %v", cookies)
+	}
+	if _, ok := cookies[CookieExpires]; ok {
+		t.Fatalf("unexpected %s for a custom policy", CookieExpires)
+	}
+	if cookies[CookieKeyPairId] != testKeyId {
+		t.Fatalf("unexpected %s %s", CookieKeyPairId, cookies[CookieKeyPairId])
+	}
+	document := decode(t, cookies[CookiePolicy])
+	if !strings.Contains(string(document), `"DateGreaterThan":{"AWS:EpochTime":1699996400}`) {
+		t.Fatalf("expected the start condition, got %s", document)
+	}
+	verify(t, publicKey, document, cookies[CookieSignature])
+}
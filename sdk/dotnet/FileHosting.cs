@@ -13,23 +13,143 @@ namespace Pulumi.Gotiac
     public partial class FileHosting : global::Pulumi.ComponentResource
     {
         /// <summary>
-        /// The ID the private key.
+        /// The ARN of the bucket the files are served from.
+        /// </summary>
+        [Output("bucketArn")]
+        public Output<string> BucketArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the bucket the files are served from.
+        /// </summary>
+        [Output("bucketName")]
+        public Output<string> BucketName { get; private set; } = null!;
+
+        /// <summary>
+        /// The IAM policy document that allows listing and reading the files of the bucket, including decrypting them with the KMS key of the bucket if it is created with SSE-KMS or bucketKmsKeyArn is set.
+        /// </summary>
+        [Output("bucketReaderPolicy")]
+        public Output<string> BucketReaderPolicy { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the managed bucket reader policy. Empty unless managedPolicies is set.
+        /// </summary>
+        [Output("bucketReaderPolicyArn")]
+        public Output<string> BucketReaderPolicyArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The IAM policy document that allows listing, reading, writing and deleting the files of the bucket, including using the KMS key of the bucket if it is created with SSE-KMS or bucketKmsKeyArn is set.
+        /// </summary>
+        [Output("bucketWriterPolicy")]
+        public Output<string> BucketWriterPolicy { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the managed bucket writer policy. Empty unless managedPolicies is set.
+        /// </summary>
+        [Output("bucketWriterPolicyArn")]
+        public Output<string> BucketWriterPolicyArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the certificate of the distribution.
+        /// </summary>
+        [Output("certificateArn")]
+        public Output<string> CertificateArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the CloudFront distribution.
+        /// </summary>
+        [Output("distributionArn")]
+        public Output<string> DistributionArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The domain name of the CloudFront distribution.
+        /// </summary>
+        [Output("distributionDomainName")]
+        public Output<string> DistributionDomainName { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the CloudFront distribution.
+        /// </summary>
+        [Output("distributionId")]
+        public Output<string> DistributionId { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the key group trusted to sign URLs and cookies.
+        /// </summary>
+        [Output("keyGroupId")]
+        public Output<string> KeyGroupId { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the bucket the access logs are written to. Empty unless logging is configured.
+        /// </summary>
+        [Output("logBucketName")]
+        public Output<string> LogBucketName { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the queue the event notifications are sent to. Empty unless the component creates the queue.
+        /// </summary>
+        [Output("notificationQueueArn")]
+        public Output<string> NotificationQueueArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the topic the event notifications are published to. Empty unless the component creates the topic.
+        /// </summary>
+        [Output("notificationTopicArn")]
+        public Output<string> NotificationTopicArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the origin access control CloudFront reads the bucket with.
+        /// </summary>
+        [Output("originAccessControlId")]
+        public Output<string> OriginAccessControlId { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the public key of the active key generation.
         /// </summary>
         [Output("privateKeyId")]
         public Output<string> PrivateKeyId { get; private set; } = null!;
 
         /// <summary>
-        /// The parameter name for the private key.
+        /// The parameter name for the private key of the active key generation.
         /// </summary>
         [Output("privateKeyParameterName")]
         public Output<string> PrivateKeyParameterName { get; private set; } = null!;
 
+        /// <summary>
+        /// The IAM policy document that allows reading the private keys to sign URLs and cookies.
+        /// </summary>
+        [Output("signerPolicy")]
+        public Output<string> SignerPolicy { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the managed signer policy. Empty unless managedPolicies is set.
+        /// </summary>
+        [Output("signerPolicyArn")]
+        public Output<string> SignerPolicyArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the public key of the active upload key generation. Empty unless the upload mode is signed-upload.
+        /// </summary>
+        [Output("uploadPrivateKeyId")]
+        public Output<string> UploadPrivateKeyId { get; private set; } = null!;
+
+        /// <summary>
+        /// The parameter name for the private key of the active upload key generation. Empty unless the upload mode is signed-upload.
+        /// </summary>
+        [Output("uploadPrivateKeyParameterName")]
+        public Output<string> UploadPrivateKeyParameterName { get; private set; } = null!;
+
         /// <summary>
         /// The file hosting URL.
         /// </summary>
         [Output("url")]
         public Output<string> Url { get; private set; } = null!;
 
+        /// <summary>
+        /// The certificate validation records to create when DNS is not managed by the component.
+        /// </summary>
+        [Output("validationRecords")]
+        public Output<ImmutableArray<Outputs.DnsRecord>> ValidationRecords { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a FileHosting resource with the given unique name, arguments, and options.
@@ -54,25 +174,406 @@ namespace Pulumi.Gotiac
             merged.Id = id ?? merged.Id;
             return merged;
         }
+
+        /// <summary>
+        /// Invalidates paths of the file hosting distribution and waits until the invalidation has completed. Every update of the stack creates a new invalidation, previews create none. The invalidation is created with the credentials of the default AWS provider of the stack.
+        /// </summary>
+        public global::Pulumi.Output<FileHostingInvalidateResult> Invalidate(FileHostingInvalidateArgs args)
+            => global::Pulumi.Deployment.Instance.Call<FileHostingInvalidateResult>("gotiac:index:FileHosting/invalidate", args ?? new FileHostingInvalidateArgs(), this);
+
+        /// <summary>
+        /// Creates the CloudFront signed cookies granting access to files of the file hosting.
+        /// </summary>
+        public global::Pulumi.Output<FileHostingSignCookiesResult> SignCookies(FileHostingSignCookiesArgs args)
+            => global::Pulumi.Deployment.Instance.Call<FileHostingSignCookiesResult>("gotiac:index:FileHosting/signCookies", args ?? new FileHostingSignCookiesArgs(), this);
+
+        /// <summary>
+        /// Creates a CloudFront signed URL for a file of the file hosting.
+        /// </summary>
+        public global::Pulumi.Output<FileHostingSignUrlResult> SignUrl(FileHostingSignUrlArgs args)
+            => global::Pulumi.Deployment.Instance.Call<FileHostingSignUrlResult>("gotiac:index:FileHosting/signUrl", args ?? new FileHostingSignUrlArgs(), this);
     }
 
     public sealed class FileHostingArgs : global::Pulumi.ResourceArgs
     {
+        [Input("aliases")]
+        private List<Input<string>>? _aliases;
+
+        /// <summary>
+        /// Additional domains the files are served under. They are added to the certificate as subject alternative names.
+        /// </summary>
+        public List<Input<string>> Aliases
+        {
+            get => _aliases ?? (_aliases = new List<Input<string>>());
+            set => _aliases = value;
+        }
+
+        /// <summary>
+        /// The ARN of the KMS key the existing bucket is encrypted with, if it uses SSE-KMS. It is added to the bucket reader and writer policies. The key policy has to allow the distribution and the roles to use the key. Requires bucketName.
+        /// </summary>
+        [Input("bucketKmsKeyArn")]
+        public Input<string>? BucketKmsKeyArn { get; set; }
+
         /// <summary>
         /// The name of an existing s3 Bucket to link as origin. If not provided, a new bucket will be created.
         /// </summary>
         [Input("bucketName")]
         public Input<string>? BucketName { get; set; }
 
+        /// <summary>
+        /// The settings of the bucket that is created when no bucket name is given. Conflicts with bucketName.
+        /// </summary>
+        [Input("bucketOptions")]
+        public Inputs.FileHostingBucketArgs? BucketOptions { get; set; }
+
+        /// <summary>
+        /// How the bucket policy is written: replace overwrites the whole policy, merge keeps the statements of other systems and only adds or updates the statements of the component. Merge requires bucketName. Defaults to replace.
+        /// </summary>
+        [Input("bucketPolicyMode")]
+        public string? BucketPolicyMode { get; set; }
+
+        /// <summary>
+        /// The region of the bucket. The bucket and its policy are managed with a provider for this region. Defaults to the region of the stack.
+        /// </summary>
+        [Input("bucketRegion")]
+        public Input<string>? BucketRegion { get; set; }
+
+        [Input("cacheBehaviors")]
+        private List<Input<Inputs.FileHostingCacheBehaviorArgs>>? _cacheBehaviors;
+
+        /// <summary>
+        /// Additional cache behaviors for path patterns, in order of precedence. Paths that match none of the patterns use the default behavior, which requires signed URLs or cookies.
+        /// </summary>
+        public List<Input<Inputs.FileHostingCacheBehaviorArgs>> CacheBehaviors
+        {
+            get => _cacheBehaviors ?? (_cacheBehaviors = new List<Input<Inputs.FileHostingCacheBehaviorArgs>>());
+            set => _cacheBehaviors = value;
+        }
+
+        /// <summary>
+        /// The settings of the cache policy to create. Conflicts with cachePolicyId.
+        /// </summary>
+        [Input("cachePolicy")]
+        public Inputs.FileHostingCachePolicyArgs? CachePolicy { get; set; }
+
+        /// <summary>
+        /// The ID of an existing cache policy to use instead of creating one.
+        /// </summary>
+        [Input("cachePolicyId")]
+        public Input<string>? CachePolicyId { get; set; }
+
+        /// <summary>
+        /// The ARN of an existing ACM certificate in us-east-1 covering the domain. If not provided, a new certificate will be issued and validated.
+        /// </summary>
+        [Input("certificateArn")]
+        public Input<string>? CertificateArn { get; set; }
+
+        /// <summary>
+        /// Whether the validation records of a new certificate have been created in the external DNS provider. Without managed DNS and certificateArn, deploying takes two updates: the first only creates the certificate and returns its validation records, the second, with this set, waits for the validation and creates the distribution. Defaults to false.
+        /// </summary>
+        [Input("certificateValidated")]
+        public bool? CertificateValidated { get; set; }
+
+        /// <summary>
+        /// The CORS settings of the response headers policy. If not provided, all origins are allowed.
+        /// </summary>
+        [Input("cors")]
+        public Inputs.FileHostingCorsArgs? Cors { get; set; }
+
         /// <summary>
         /// The file hosting domain.
         /// </summary>
         [Input("domain", required: true)]
-        public Input<string> Domain { get; set; } = null!;
+        public string Domain { get; set; } = null!;
+
+        /// <summary>
+        /// The CloudFront Functions and Lambda@Edge functions of the default cache behavior. They also run on the upload behavior of the signed-upload mode.
+        /// </summary>
+        [Input("edgeHandlers")]
+        public Inputs.FileHostingEdgeHandlersArgs? EdgeHandlers { get; set; }
+
+        /// <summary>
+        /// The bucket CloudFront fails over to when the bucket returns an error. If not provided, requests are only served from the bucket.
+        /// </summary>
+        [Input("failover")]
+        public Inputs.FileHostingFailoverArgs? Failover { get; set; }
+
+        /// <summary>
+        /// The countries requests are allowed from or blocked from. If not provided, requests from all countries are served.
+        /// </summary>
+        [Input("geoRestriction")]
+        public Inputs.FileHostingGeoRestrictionArgs? GeoRestriction { get; set; }
+
+        /// <summary>
+        /// The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+        /// </summary>
+        [Input("hostedZoneId")]
+        public Input<string>? HostedZoneId { get; set; }
+
+        /// <summary>
+        /// Whether the distribution is reachable over IPv6. If true, AAAA records are created next to the A records. Defaults to true.
+        /// </summary>
+        [Input("ipv6Enabled")]
+        public bool? Ipv6Enabled { get; set; }
+
+        /// <summary>
+        /// The rotation of the keys trusted to sign URLs and cookies. If not provided, a single key is used.
+        /// </summary>
+        [Input("keyRotation")]
+        public Inputs.FileHostingKeyRotationArgs? KeyRotation { get; set; }
+
+        /// <summary>
+        /// The access logging settings. If not provided, no access logs are written.
+        /// </summary>
+        [Input("logging")]
+        public Inputs.FileHostingLoggingArgs? Logging { get; set; }
+
+        /// <summary>
+        /// Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Defaults to true.
+        /// </summary>
+        [Input("manageDns")]
+        public bool? ManageDns { get; set; }
+
+        /// <summary>
+        /// Whether the ownership controls of the bucket are managed by the component. Defaults to true.
+        /// </summary>
+        [Input("manageOwnershipControls")]
+        public bool? ManageOwnershipControls { get; set; }
+
+        /// <summary>
+        /// Whether the public access block of the bucket is managed by the component. Defaults to true.
+        /// </summary>
+        [Input("managePublicAccessBlock")]
+        public bool? ManagePublicAccessBlock { get; set; }
+
+        /// <summary>
+        /// Whether managed IAM policies are created for the signer, bucket reader and bucket writer roles. The policy documents are returned either way. Defaults to false.
+        /// </summary>
+        [Input("managedPolicies")]
+        public bool? ManagedPolicies { get; set; }
+
+        /// <summary>
+        /// The event notifications of the bucket, e.g. to post-process uploaded files. If not provided, no notifications are configured.
+        /// </summary>
+        [Input("notifications")]
+        public Inputs.FileHostingNotificationsArgs? Notifications { get; set; }
+
+        /// <summary>
+        /// The settings of the origin request policy to create. Conflicts with originRequestPolicyId.
+        /// </summary>
+        [Input("originRequestPolicy")]
+        public Inputs.FileHostingOriginRequestPolicyArgs? OriginRequestPolicy { get; set; }
+
+        /// <summary>
+        /// The ID of an existing origin request policy to use instead of creating one.
+        /// </summary>
+        [Input("originRequestPolicyId")]
+        public Input<string>? OriginRequestPolicyId { get; set; }
+
+        /// <summary>
+        /// The region of the Origin Shield requests to the buckets go through. If not provided, Origin Shield is disabled.
+        /// </summary>
+        [Input("originShieldRegion")]
+        public Input<string>? OriginShieldRegion { get; set; }
+
+        /// <summary>
+        /// The price class of the distribution: PriceClass_All, PriceClass_200 or PriceClass_100. Defaults to PriceClass_All.
+        /// </summary>
+        [Input("priceClass")]
+        public string? PriceClass { get; set; }
+
+        /// <summary>
+        /// The security headers of the response headers policy.
+        /// </summary>
+        [Input("securityHeaders")]
+        public Inputs.FileHostingSecurityHeadersArgs? SecurityHeaders { get; set; }
+
+        /// <summary>
+        /// Which writes CloudFront passes to the bucket: full allows uploads through the default behavior, signed-upload only through the upload path pattern with its own key group and read-only none at all. Defaults to full.
+        /// </summary>
+        [Input("uploadMode")]
+        public string? UploadMode { get; set; }
+
+        /// <summary>
+        /// The path pattern uploads are accepted under in signed-upload mode. Defaults to uploads/*.
+        /// </summary>
+        [Input("uploadPathPattern")]
+        public string? UploadPathPattern { get; set; }
+
+        /// <summary>
+        /// The settings of a WAF web ACL to create in us-east-1 and attach to the distribution. Conflicts with webAclArn.
+        /// </summary>
+        [Input("webAcl")]
+        public Inputs.WebAclArgs? WebAcl { get; set; }
+
+        /// <summary>
+        /// The ARN of an existing WAF web ACL to attach to the distribution. Conflicts with webAcl.
+        /// </summary>
+        [Input("webAclArn")]
+        public Input<string>? WebAclArn { get; set; }
 
         public FileHostingArgs()
         {
         }
         public static new FileHostingArgs Empty => new FileHostingArgs();
     }
+
+    /// <summary>
+    /// The set of arguments for the <see cref="FileHosting.Invalidate"/> method.
+    /// </summary>
+    public sealed class FileHostingInvalidateArgs : global::Pulumi.CallArgs
+    {
+        [Input("paths", required: true)]
+        private InputList<string>? _paths;
+
+        /// <summary>
+        /// The paths to invalidate, relative to the file hosting URL. Paths start with / and may end with a * wildcard.
+        /// </summary>
+        public InputList<string> Paths
+        {
+            get => _paths ?? (_paths = new InputList<string>());
+            set => _paths = value;
+        }
+
+        public FileHostingInvalidateArgs()
+        {
+        }
+        public static new FileHostingInvalidateArgs Empty => new FileHostingInvalidateArgs();
+    }
+
+    /// <summary>
+    /// The results of the <see cref="FileHosting.Invalidate"/> method.
+    /// </summary>
+    [OutputType]
+    public sealed class FileHostingInvalidateResult
+    {
+        /// <summary>
+        /// The ID of the invalidation. Empty in previews.
+        /// </summary>
+        public readonly string InvalidationId;
+
+        [OutputConstructor]
+        private FileHostingInvalidateResult(string invalidationId)
+        {
+            InvalidationId = invalidationId;
+        }
+    }
+
+    /// <summary>
+    /// The set of arguments for the <see cref="FileHosting.SignCookies"/> method.
+    /// </summary>
+    public sealed class FileHostingSignCookiesArgs : global::Pulumi.CallArgs
+    {
+        /// <summary>
+        /// The time the signature expires at, in RFC 3339 format.
+        /// </summary>
+        [Input("expiresAt", required: true)]
+        public Input<string> ExpiresAt { get; set; } = null!;
+
+        /// <summary>
+        /// The IP address or CIDR range access is restricted to. Requires a custom policy.
+        /// </summary>
+        [Input("ipAddress")]
+        public Input<string>? IpAddress { get; set; }
+
+        /// <summary>
+        /// The path of the file, relative to the file hosting URL. It is the unescaped object key, which is escaped for the URL. May contain * wildcards.
+        /// </summary>
+        [Input("path", required: true)]
+        public Input<string> Path { get; set; } = null!;
+
+        /// <summary>
+        /// The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+        /// </summary>
+        [Input("startsAt")]
+        public Input<string>? StartsAt { get; set; }
+
+        /// <summary>
+        /// Whether to sign with the upload key. Requires the signed-upload upload mode.
+        /// </summary>
+        [Input("upload")]
+        public bool? Upload { get; set; }
+
+        public FileHostingSignCookiesArgs()
+        {
+        }
+        public static new FileHostingSignCookiesArgs Empty => new FileHostingSignCookiesArgs();
+    }
+
+    /// <summary>
+    /// The results of the <see cref="FileHosting.SignCookies"/> method.
+    /// </summary>
+    [OutputType]
+    public sealed class FileHostingSignCookiesResult
+    {
+        /// <summary>
+        /// The signed cookies by name.
+        /// </summary>
+        public readonly ImmutableDictionary<string, string> Cookies;
+
+        [OutputConstructor]
+        private FileHostingSignCookiesResult(ImmutableDictionary<string, string> cookies)
+        {
+            Cookies = cookies;
+        }
+    }
+
+    /// <summary>
+    /// The set of arguments for the <see cref="FileHosting.SignUrl"/> method.
+    /// </summary>
+    public sealed class FileHostingSignUrlArgs : global::Pulumi.CallArgs
+    {
+        /// <summary>
+        /// The time the signature expires at, in RFC 3339 format.
+        /// </summary>
+        [Input("expiresAt", required: true)]
+        public Input<string> ExpiresAt { get; set; } = null!;
+
+        /// <summary>
+        /// The IP address or CIDR range access is restricted to. Requires a custom policy.
+        /// </summary>
+        [Input("ipAddress")]
+        public Input<string>? IpAddress { get; set; }
+
+        /// <summary>
+        /// The path of the file, relative to the file hosting URL. It is the unescaped object key, which is escaped for the URL.
+        /// </summary>
+        [Input("path", required: true)]
+        public Input<string> Path { get; set; } = null!;
+
+        /// <summary>
+        /// The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+        /// </summary>
+        [Input("startsAt")]
+        public Input<string>? StartsAt { get; set; }
+
+        /// <summary>
+        /// Whether to sign with the upload key. Requires the signed-upload upload mode.
+        /// </summary>
+        [Input("upload")]
+        public bool? Upload { get; set; }
+
+        public FileHostingSignUrlArgs()
+        {
+        }
+        public static new FileHostingSignUrlArgs Empty => new FileHostingSignUrlArgs();
+    }
+
+    /// <summary>
+    /// The results of the <see cref="FileHosting.SignUrl"/> method.
+    /// </summary>
+    [OutputType]
+    public sealed class FileHostingSignUrlResult
+    {
+        /// <summary>
+        /// The signed URL.
+        /// </summary>
+        public readonly string Url;
+
+        [OutputConstructor]
+        private FileHostingSignUrlResult(string url)
+        {
+            Url = url;
+        }
+    }
 }
@@ -0,0 +1,77 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The settings of the bucket a FileHosting creates when no bucket name is given.
+    /// </summary>
+    public sealed class FileHostingBucketArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The number of days after which incomplete multipart uploads are aborted. Defaults to 7, 0 disables the rule.
+        /// </summary>
+        [Input("abortIncompleteMultipartUploadDays")]
+        public int? AbortIncompleteMultipartUploadDays { get; set; }
+
+        /// <summary>
+        /// The server side encryption of the bucket: SSE-S3 or SSE-KMS. Defaults to SSE-S3.
+        /// </summary>
+        [Input("encryption")]
+        public string? Encryption { get; set; }
+
+        /// <summary>
+        /// Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+        /// </summary>
+        [Input("forceDestroy")]
+        public bool? ForceDestroy { get; set; }
+
+        /// <summary>
+        /// The ARN of the KMS key used with SSE-KMS. Its key policy has to allow CloudFront to use it. If not provided, a key is generated.
+        /// </summary>
+        [Input("kmsKeyArn")]
+        public Input<string>? KmsKeyArn { get; set; }
+
+        [Input("lifecycleRules")]
+        private List<Input<Inputs.FileHostingLifecycleRuleArgs>>? _lifecycleRules;
+
+        /// <summary>
+        /// The lifecycle rules of the bucket.
+        /// </summary>
+        public List<Input<Inputs.FileHostingLifecycleRuleArgs>> LifecycleRules
+        {
+            get => _lifecycleRules ?? (_lifecycleRules = new List<Input<Inputs.FileHostingLifecycleRuleArgs>>());
+            set => _lifecycleRules = value;
+        }
+
+        /// <summary>
+        /// Whether the bucket is protected from being deleted by Pulumi. Defaults to false.
+        /// </summary>
+        [Input("protect")]
+        public bool? Protect { get; set; }
+
+        /// <summary>
+        /// Whether the bucket is kept in AWS when it is deleted from the stack. Defaults to false.
+        /// </summary>
+        [Input("retainOnDelete")]
+        public bool? RetainOnDelete { get; set; }
+
+        /// <summary>
+        /// Whether versioning is enabled. Defaults to false.
+        /// </summary>
+        [Input("versioning")]
+        public bool? Versioning { get; set; }
+
+        public FileHostingBucketArgs()
+        {
+        }
+        public static new FileHostingBucketArgs Empty => new FileHostingBucketArgs();
+    }
+}
@@ -0,0 +1,59 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// A cache behavior of a FileHosting for a path pattern.
+    /// </summary>
+    public sealed class FileHostingCacheBehaviorArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("allowedMethods")]
+        private InputList<string>? _allowedMethods;
+
+        /// <summary>
+        /// The HTTP methods CloudFront processes and forwards to the bucket. Defaults to GET, HEAD and OPTIONS. With failover, a behavior allowing other methods targets the bucket directly.
+        /// </summary>
+        public InputList<string> AllowedMethods
+        {
+            get => _allowedMethods ?? (_allowedMethods = new InputList<string>());
+            set => _allowedMethods = value;
+        }
+
+        /// <summary>
+        /// The ID of the cache policy of the behavior. Defaults to the cache policy of the component.
+        /// </summary>
+        [Input("cachePolicyId")]
+        public Input<string>? CachePolicyId { get; set; }
+
+        /// <summary>
+        /// The CloudFront Functions and Lambda@Edge functions of the behavior.
+        /// </summary>
+        [Input("edgeHandlers")]
+        public Inputs.FileHostingEdgeHandlersArgs? EdgeHandlers { get; set; }
+
+        /// <summary>
+        /// The path pattern the behavior applies to, e.g. public/*.
+        /// </summary>
+        [Input("pathPattern", required: true)]
+        public Input<string> PathPattern { get; set; } = null!;
+
+        /// <summary>
+        /// Whether requests require signed URLs or cookies. Defaults to true.
+        /// </summary>
+        [Input("signed")]
+        public bool? Signed { get; set; }
+
+        public FileHostingCacheBehaviorArgs()
+        {
+        }
+        public static new FileHostingCacheBehaviorArgs Empty => new FileHostingCacheBehaviorArgs();
+    }
+}
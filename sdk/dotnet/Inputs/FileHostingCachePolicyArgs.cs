@@ -0,0 +1,107 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The settings of the cache policy a FileHosting creates.
+    /// </summary>
+    public sealed class FileHostingCachePolicyArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Which cookies are part of the cache key: none, whitelist, allExcept or all. Defaults to none.
+        /// </summary>
+        [Input("cookieBehavior")]
+        public Input<string>? CookieBehavior { get; set; }
+
+        [Input("cookies")]
+        private InputList<string>? _cookies;
+
+        /// <summary>
+        /// The cookies the cookie behavior applies to.
+        /// </summary>
+        public InputList<string> Cookies
+        {
+            get => _cookies ?? (_cookies = new InputList<string>());
+            set => _cookies = value;
+        }
+
+        /// <summary>
+        /// The default time objects stay in the cache, in seconds. Defaults to 86400.
+        /// </summary>
+        [Input("defaultTtl")]
+        public Input<int>? DefaultTtl { get; set; }
+
+        /// <summary>
+        /// Whether brotli compressed objects are cached and served. Defaults to false.
+        /// </summary>
+        [Input("enableAcceptEncodingBrotli")]
+        public Input<bool>? EnableAcceptEncodingBrotli { get; set; }
+
+        /// <summary>
+        /// Whether gzip compressed objects are cached and served. Defaults to false.
+        /// </summary>
+        [Input("enableAcceptEncodingGzip")]
+        public Input<bool>? EnableAcceptEncodingGzip { get; set; }
+
+        /// <summary>
+        /// Which headers are part of the cache key: none or whitelist. Defaults to none.
+        /// </summary>
+        [Input("headerBehavior")]
+        public Input<string>? HeaderBehavior { get; set; }
+
+        [Input("headers")]
+        private InputList<string>? _headers;
+
+        /// <summary>
+        /// The headers the header behavior applies to.
+        /// </summary>
+        public InputList<string> Headers
+        {
+            get => _headers ?? (_headers = new InputList<string>());
+            set => _headers = value;
+        }
+
+        /// <summary>
+        /// The maximum time objects stay in the cache, in seconds. Defaults to 31536000.
+        /// </summary>
+        [Input("maxTtl")]
+        public Input<int>? MaxTtl { get; set; }
+
+        /// <summary>
+        /// The minimum time objects stay in the cache, in seconds. Defaults to 1.
+        /// </summary>
+        [Input("minTtl")]
+        public Input<int>? MinTtl { get; set; }
+
+        /// <summary>
+        /// Which query strings are part of the cache key: none, whitelist, allExcept or all. Defaults to whitelist.
+        /// </summary>
+        [Input("queryStringBehavior")]
+        public Input<string>? QueryStringBehavior { get; set; }
+
+        [Input("queryStrings")]
+        private InputList<string>? _queryStrings;
+
+        /// <summary>
+        /// The query strings the query string behavior applies to. Defaults to etag.
+        /// </summary>
+        public InputList<string> QueryStrings
+        {
+            get => _queryStrings ?? (_queryStrings = new InputList<string>());
+            set => _queryStrings = value;
+        }
+
+        public FileHostingCachePolicyArgs()
+        {
+        }
+        public static new FileHostingCachePolicyArgs Empty => new FileHostingCachePolicyArgs();
+    }
+}
@@ -0,0 +1,83 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The CORS settings of the response headers policy of a FileHosting.
+    /// </summary>
+    public sealed class FileHostingCorsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether cross-origin requests may include credentials. Requires explicit allowed origins. Defaults to false.
+        /// </summary>
+        [Input("allowCredentials")]
+        public bool? AllowCredentials { get; set; }
+
+        [Input("allowedHeaders")]
+        private InputList<string>? _allowedHeaders;
+
+        /// <summary>
+        /// The headers allowed in cross-origin requests. Defaults to all headers.
+        /// </summary>
+        public InputList<string> AllowedHeaders
+        {
+            get => _allowedHeaders ?? (_allowedHeaders = new InputList<string>());
+            set => _allowedHeaders = value;
+        }
+
+        [Input("allowedMethods")]
+        private InputList<string>? _allowedMethods;
+
+        /// <summary>
+        /// The HTTP methods allowed in cross-origin requests. Defaults to ALL.
+        /// </summary>
+        public InputList<string> AllowedMethods
+        {
+            get => _allowedMethods ?? (_allowedMethods = new InputList<string>());
+            set => _allowedMethods = value;
+        }
+
+        [Input("allowedOrigins")]
+        private List<Input<string>>? _allowedOrigins;
+
+        /// <summary>
+        /// The origins allowed to access the files. Defaults to all origins.
+        /// </summary>
+        public List<Input<string>> AllowedOrigins
+        {
+            get => _allowedOrigins ?? (_allowedOrigins = new List<Input<string>>());
+            set => _allowedOrigins = value;
+        }
+
+        [Input("exposeHeaders")]
+        private InputList<string>? _exposeHeaders;
+
+        /// <summary>
+        /// The headers exposed to cross-origin responses. Defaults to ETag, which multipart uploads need.
+        /// </summary>
+        public InputList<string> ExposeHeaders
+        {
+            get => _exposeHeaders ?? (_exposeHeaders = new InputList<string>());
+            set => _exposeHeaders = value;
+        }
+
+        /// <summary>
+        /// The time browsers may cache preflight responses, in seconds. Defaults to 600.
+        /// </summary>
+        [Input("maxAgeSeconds")]
+        public Input<int>? MaxAgeSeconds { get; set; }
+
+        public FileHostingCorsArgs()
+        {
+        }
+        public static new FileHostingCorsArgs Empty => new FileHostingCorsArgs();
+    }
+}
@@ -0,0 +1,59 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The edge handlers of a cache behavior of a FileHosting. Each event type takes either a CloudFront Function or a Lambda@Edge function.
+    /// </summary>
+    public sealed class FileHostingEdgeHandlersArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin requests.
+        /// </summary>
+        [Input("originRequestLambdaArn")]
+        public Input<string>? OriginRequestLambdaArn { get; set; }
+
+        /// <summary>
+        /// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin responses, e.g. to set the Content-Disposition header before the response is cached.
+        /// </summary>
+        [Input("originResponseLambdaArn")]
+        public Input<string>? OriginResponseLambdaArn { get; set; }
+
+        /// <summary>
+        /// The code of a CloudFront Function run on viewer requests, e.g. to rewrite paths or check tokens. The function is created and published with the cloudfront-js-2.0 runtime.
+        /// </summary>
+        [Input("viewerRequestFunction")]
+        public Input<string>? ViewerRequestFunction { get; set; }
+
+        /// <summary>
+        /// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer requests.
+        /// </summary>
+        [Input("viewerRequestLambdaArn")]
+        public Input<string>? ViewerRequestLambdaArn { get; set; }
+
+        /// <summary>
+        /// The code of a CloudFront Function run on viewer responses, e.g. to set headers.
+        /// </summary>
+        [Input("viewerResponseFunction")]
+        public Input<string>? ViewerResponseFunction { get; set; }
+
+        /// <summary>
+        /// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer responses.
+        /// </summary>
+        [Input("viewerResponseLambdaArn")]
+        public Input<string>? ViewerResponseLambdaArn { get; set; }
+
+        public FileHostingEdgeHandlersArgs()
+        {
+        }
+        public static new FileHostingEdgeHandlersArgs Empty => new FileHostingEdgeHandlersArgs();
+    }
+}
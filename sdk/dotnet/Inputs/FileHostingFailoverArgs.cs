@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The bucket a FileHosting fails over to.
+    /// </summary>
+    public sealed class FileHostingFailoverArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The name of an existing bucket to fail over to. If not provided, a bucket is created.
+        /// </summary>
+        [Input("bucketName")]
+        public Input<string>? BucketName { get; set; }
+
+        /// <summary>
+        /// The region of the failover bucket. Defaults to the region of the stack.
+        /// </summary>
+        [Input("bucketRegion")]
+        public Input<string>? BucketRegion { get; set; }
+
+        /// <summary>
+        /// Whether the objects of the bucket are replicated to the failover bucket. Both buckets need versioning enabled. Defaults to false.
+        /// </summary>
+        [Input("replication")]
+        public bool? Replication { get; set; }
+
+        [Input("statusCodes")]
+        private List<Input<int>>? _statusCodes;
+
+        /// <summary>
+        /// The status codes of the bucket on which CloudFront retries the request with the failover bucket. Defaults to 500, 502, 503, 504, 403 and 404.
+        /// </summary>
+        public List<Input<int>> StatusCodes
+        {
+            get => _statusCodes ?? (_statusCodes = new List<Input<int>>());
+            set => _statusCodes = value;
+        }
+
+        public FileHostingFailoverArgs()
+        {
+        }
+        public static new FileHostingFailoverArgs Empty => new FileHostingFailoverArgs();
+    }
+}
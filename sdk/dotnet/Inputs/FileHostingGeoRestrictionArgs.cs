@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The geo restriction of a FileHosting.
+    /// </summary>
+    public sealed class FileHostingGeoRestrictionArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("locations")]
+        private List<Input<string>>? _locations;
+
+        /// <summary>
+        /// The ISO 3166-1 alpha-2 codes of the countries the restriction type applies to.
+        /// </summary>
+        public List<Input<string>> Locations
+        {
+            get => _locations ?? (_locations = new List<Input<string>>());
+            set => _locations = value;
+        }
+
+        /// <summary>
+        /// How the locations are applied: whitelist only allows requests from them, blacklist blocks them and none disables the restriction.
+        /// </summary>
+        [Input("restrictionType", required: true)]
+        public string RestrictionType { get; set; } = null!;
+
+        public FileHostingGeoRestrictionArgs()
+        {
+        }
+        public static new FileHostingGeoRestrictionArgs Empty => new FileHostingGeoRestrictionArgs();
+    }
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The key rotation settings of a FileHosting.
+    /// </summary>
+    public sealed class FileHostingKeyRotationArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The generation of the key used for signing. Incrementing it creates a new key and rotates signing to it. Defaults to 1.
+        /// </summary>
+        [Input("activeGeneration")]
+        public int? ActiveGeneration { get; set; }
+
+        /// <summary>
+        /// The number of key generations kept in the trusted key group, including the active one. Previous keys stay trusted so that outstanding signatures remain valid. Defaults to 2.
+        /// </summary>
+        [Input("generations")]
+        public int? Generations { get; set; }
+
+        public FileHostingKeyRotationArgs()
+        {
+        }
+        public static new FileHostingKeyRotationArgs Empty => new FileHostingKeyRotationArgs();
+    }
+}
@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// A lifecycle rule of the bucket of a FileHosting.
+    /// </summary>
+    public sealed class FileHostingLifecycleRuleArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The number of days after which objects expire.
+        /// </summary>
+        [Input("expirationDays")]
+        public Input<int>? ExpirationDays { get; set; }
+
+        /// <summary>
+        /// The number of days after which noncurrent object versions expire.
+        /// </summary>
+        [Input("noncurrentVersionExpirationDays")]
+        public Input<int>? NoncurrentVersionExpirationDays { get; set; }
+
+        /// <summary>
+        /// The key prefix the rule applies to. If not provided, the rule applies to all objects.
+        /// </summary>
+        [Input("prefix")]
+        public Input<string>? Prefix { get; set; }
+
+        [Input("transitions")]
+        private List<Input<Inputs.FileHostingLifecycleTransitionArgs>>? _transitions;
+
+        /// <summary>
+        /// The transitions of objects to other storage classes.
+        /// </summary>
+        public List<Input<Inputs.FileHostingLifecycleTransitionArgs>> Transitions
+        {
+            get => _transitions ?? (_transitions = new List<Input<Inputs.FileHostingLifecycleTransitionArgs>>());
+            set => _transitions = value;
+        }
+
+        public FileHostingLifecycleRuleArgs()
+        {
+        }
+        public static new FileHostingLifecycleRuleArgs Empty => new FileHostingLifecycleRuleArgs();
+    }
+}
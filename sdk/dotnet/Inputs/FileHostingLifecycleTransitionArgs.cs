@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// A transition of objects to another storage class.
+    /// </summary>
+    public sealed class FileHostingLifecycleTransitionArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The number of days after which objects are transitioned.
+        /// </summary>
+        [Input("days", required: true)]
+        public Input<int> Days { get; set; } = null!;
+
+        /// <summary>
+        /// The storage class objects are transitioned to, e.g. STANDARD_IA or GLACIER_IR.
+        /// </summary>
+        [Input("storageClass", required: true)]
+        public Input<string> StorageClass { get; set; } = null!;
+
+        public FileHostingLifecycleTransitionArgs()
+        {
+        }
+        public static new FileHostingLifecycleTransitionArgs Empty => new FileHostingLifecycleTransitionArgs();
+    }
+}
@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The access logging settings of a FileHosting.
+    /// </summary>
+    public sealed class FileHostingLoggingArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The name of an existing bucket to write the logs to. It needs ACLs enabled for CloudFront standard logs and a bucket policy allowing S3 server access logs. If not provided, a log bucket is created.
+        /// </summary>
+        [Input("bucketName")]
+        public Input<string>? BucketName { get; set; }
+
+        /// <summary>
+        /// The key prefix of the CloudFront standard logs. Defaults to cloudfront/.
+        /// </summary>
+        [Input("cloudFrontPrefix")]
+        public Input<string>? CloudFrontPrefix { get; set; }
+
+        /// <summary>
+        /// Whether CloudFront logs include cookies. Defaults to false.
+        /// </summary>
+        [Input("includeCookies")]
+        public Input<bool>? IncludeCookies { get; set; }
+
+        /// <summary>
+        /// The number of days logs are kept in a created log bucket. Defaults to 90.
+        /// </summary>
+        [Input("retentionDays")]
+        public Input<int>? RetentionDays { get; set; }
+
+        /// <summary>
+        /// The key prefix of the S3 server access logs. Defaults to s3/.
+        /// </summary>
+        [Input("s3Prefix")]
+        public Input<string>? S3Prefix { get; set; }
+
+        public FileHostingLoggingArgs()
+        {
+        }
+        public static new FileHostingLoggingArgs Empty => new FileHostingLoggingArgs();
+    }
+}
@@ -0,0 +1,83 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The event notifications of the bucket of a FileHosting. The notification configuration replaces any existing configuration of the bucket. The events go to a single destination and optionally to EventBridge.
+    /// </summary>
+    public sealed class FileHostingNotificationsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether all events of the bucket are sent to EventBridge. Defaults to false.
+        /// </summary>
+        [Input("eventBridge")]
+        public bool? EventBridge { get; set; }
+
+        [Input("events")]
+        private List<Input<string>>? _events;
+
+        /// <summary>
+        /// The S3 events notifications are sent for. Defaults to s3:ObjectCreated:*.
+        /// </summary>
+        public List<Input<string>> Events
+        {
+            get => _events ?? (_events = new List<Input<string>>());
+            set => _events = value;
+        }
+
+        /// <summary>
+        /// The key prefix of the objects notifications are sent for, e.g. uploads/.
+        /// </summary>
+        [Input("filterPrefix")]
+        public Input<string>? FilterPrefix { get; set; }
+
+        /// <summary>
+        /// The key suffix of the objects notifications are sent for, e.g. .jpg.
+        /// </summary>
+        [Input("filterSuffix")]
+        public Input<string>? FilterSuffix { get; set; }
+
+        /// <summary>
+        /// The ARN of a Lambda function in the region of the bucket to invoke. The permission for S3 to invoke it is created. Conflicts with the other destinations.
+        /// </summary>
+        [Input("lambdaFunctionArn")]
+        public Input<string>? LambdaFunctionArn { get; set; }
+
+        /// <summary>
+        /// Whether to create an SQS queue the notifications are sent to. Conflicts with the other destinations.
+        /// </summary>
+        [Input("queue")]
+        public bool? Queue { get; set; }
+
+        /// <summary>
+        /// The ARN of an existing SQS queue to send the notifications to. Its policy has to allow S3 to send messages. Conflicts with the other destinations.
+        /// </summary>
+        [Input("queueArn")]
+        public Input<string>? QueueArn { get; set; }
+
+        /// <summary>
+        /// Whether to create an SNS topic the notifications are published to. Conflicts with the other destinations.
+        /// </summary>
+        [Input("topic")]
+        public bool? Topic { get; set; }
+
+        /// <summary>
+        /// The ARN of an existing SNS topic to publish the notifications to. Its policy has to allow S3 to publish. Conflicts with the other destinations.
+        /// </summary>
+        [Input("topicArn")]
+        public Input<string>? TopicArn { get; set; }
+
+        public FileHostingNotificationsArgs()
+        {
+        }
+        public static new FileHostingNotificationsArgs Empty => new FileHostingNotificationsArgs();
+    }
+}
@@ -0,0 +1,77 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The settings of the origin request policy a FileHosting creates.
+    /// </summary>
+    public sealed class FileHostingOriginRequestPolicyArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Which cookies are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to none.
+        /// </summary>
+        [Input("cookieBehavior")]
+        public Input<string>? CookieBehavior { get; set; }
+
+        [Input("cookies")]
+        private InputList<string>? _cookies;
+
+        /// <summary>
+        /// The cookies the cookie behavior applies to.
+        /// </summary>
+        public InputList<string> Cookies
+        {
+            get => _cookies ?? (_cookies = new InputList<string>());
+            set => _cookies = value;
+        }
+
+        /// <summary>
+        /// Which headers are forwarded to the bucket: none, whitelist, allViewer, allViewerAndWhitelistCloudFront or allExcept. Defaults to whitelist.
+        /// </summary>
+        [Input("headerBehavior")]
+        public Input<string>? HeaderBehavior { get; set; }
+
+        [Input("headers")]
+        private InputList<string>? _headers;
+
+        /// <summary>
+        /// The headers the header behavior applies to. Defaults to Content-Type.
+        /// </summary>
+        public InputList<string> Headers
+        {
+            get => _headers ?? (_headers = new InputList<string>());
+            set => _headers = value;
+        }
+
+        /// <summary>
+        /// Which query strings are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to whitelist.
+        /// </summary>
+        [Input("queryStringBehavior")]
+        public Input<string>? QueryStringBehavior { get; set; }
+
+        [Input("queryStrings")]
+        private InputList<string>? _queryStrings;
+
+        /// <summary>
+        /// The query strings the query string behavior applies to. Defaults to partNumber and uploadId, which multipart uploads need.
+        /// </summary>
+        public InputList<string> QueryStrings
+        {
+            get => _queryStrings ?? (_queryStrings = new InputList<string>());
+            set => _queryStrings = value;
+        }
+
+        public FileHostingOriginRequestPolicyArgs()
+        {
+        }
+        public static new FileHostingOriginRequestPolicyArgs Empty => new FileHostingOriginRequestPolicyArgs();
+    }
+}
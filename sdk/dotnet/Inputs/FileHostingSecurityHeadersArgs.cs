@@ -0,0 +1,47 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The security headers of the response headers policy of a FileHosting.
+    /// </summary>
+    public sealed class FileHostingSecurityHeadersArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The Content-Security-Policy header. Defaults to default-src 'none', so hosted files cannot load or run anything when opened directly.
+        /// </summary>
+        [Input("contentSecurityPolicy")]
+        public Input<string>? ContentSecurityPolicy { get; set; }
+
+        /// <summary>
+        /// Whether the Strict-Transport-Security header includes subdomains. Defaults to true.
+        /// </summary>
+        [Input("strictTransportSecurityIncludeSubdomains")]
+        public Input<bool>? StrictTransportSecurityIncludeSubdomains { get; set; }
+
+        /// <summary>
+        /// The max age of the Strict-Transport-Security header, in seconds. Defaults to 31536000.
+        /// </summary>
+        [Input("strictTransportSecurityMaxAge")]
+        public Input<int>? StrictTransportSecurityMaxAge { get; set; }
+
+        /// <summary>
+        /// Whether the Strict-Transport-Security header allows preloading. Defaults to false.
+        /// </summary>
+        [Input("strictTransportSecurityPreload")]
+        public Input<bool>? StrictTransportSecurityPreload { get; set; }
+
+        public FileHostingSecurityHeadersArgs()
+        {
+        }
+        public static new FileHostingSecurityHeadersArgs Empty => new FileHostingSecurityHeadersArgs();
+    }
+}
@@ -0,0 +1,65 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Inputs
+{
+
+    /// <summary>
+    /// The settings of a WAF web ACL created for a CloudFront distribution.
+    /// </summary>
+    public sealed class WebAclArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("allowedIpAddresses")]
+        private List<Input<string>>? _allowedIpAddresses;
+
+        /// <summary>
+        /// The IP addresses and CIDR ranges that are allowed. If provided, all other requests are blocked.
+        /// </summary>
+        public List<Input<string>> AllowedIpAddresses
+        {
+            get => _allowedIpAddresses ?? (_allowedIpAddresses = new List<Input<string>>());
+            set => _allowedIpAddresses = value;
+        }
+
+        [Input("blockedIpAddresses")]
+        private List<Input<string>>? _blockedIpAddresses;
+
+        /// <summary>
+        /// The IP addresses and CIDR ranges that are blocked.
+        /// </summary>
+        public List<Input<string>> BlockedIpAddresses
+        {
+            get => _blockedIpAddresses ?? (_blockedIpAddresses = new List<Input<string>>());
+            set => _blockedIpAddresses = value;
+        }
+
+        [Input("managedRuleGroups")]
+        private List<Input<string>>? _managedRuleGroups;
+
+        /// <summary>
+        /// The names of the AWS managed rule groups to apply. Defaults to AWSManagedRulesAmazonIpReputationList and AWSManagedRulesKnownBadInputsRuleSet.
+        /// </summary>
+        public List<Input<string>> ManagedRuleGroups
+        {
+            get => _managedRuleGroups ?? (_managedRuleGroups = new List<Input<string>>());
+            set => _managedRuleGroups = value;
+        }
+
+        /// <summary>
+        /// The maximum number of requests a single IP address may make in 5 minutes before it is blocked. Defaults to 2000, 0 disables rate limiting.
+        /// </summary>
+        [Input("rateLimit")]
+        public int? RateLimit { get; set; }
+
+        public WebAclArgs()
+        {
+        }
+        public static new WebAclArgs Empty => new WebAclArgs();
+    }
+}
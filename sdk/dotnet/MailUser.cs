@@ -0,0 +1,150 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac
+{
+    [GotiacResourceType("gotiac:index:MailUser")]
+    public partial class MailUser : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The SES verification and DKIM records to create when DNS is not managed by the component.
+        /// </summary>
+        [Output("dnsRecords")]
+        public Output<ImmutableArray<Outputs.DnsRecord>> DnsRecords { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the SES identity of the domain.
+        /// </summary>
+        [Output("domainIdentityArn")]
+        public Output<string> DomainIdentityArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The email address of the mailbox.
+        /// </summary>
+        [Output("emailAddress")]
+        public Output<string> EmailAddress { get; private set; } = null!;
+
+        /// <summary>
+        /// The sender address including the display name.
+        /// </summary>
+        [Output("from")]
+        public Output<string> From { get; private set; } = null!;
+
+        /// <summary>
+        /// The SES SMTP endpoint.
+        /// </summary>
+        [Output("smtpEndpoint")]
+        public Output<string> SmtpEndpoint { get; private set; } = null!;
+
+        /// <summary>
+        /// The parameter name for the SMTP password.
+        /// </summary>
+        [Output("smtpPasswordParameterName")]
+        public Output<string> SmtpPasswordParameterName { get; private set; } = null!;
+
+        /// <summary>
+        /// The parameter name for the SMTP username.
+        /// </summary>
+        [Output("smtpUsernameParameterName")]
+        public Output<string> SmtpUsernameParameterName { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a MailUser resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public MailUser(string name, MailUserArgs args, ComponentResourceOptions? options = null)
+            : base("gotiac:index:MailUser", name, args ?? new MailUserArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class MailUserArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The display name used in the sender address.
+        /// </summary>
+        [Input("displayName")]
+        public Input<string>? DisplayName { get; set; }
+
+        /// <summary>
+        /// The mail domain.
+        /// </summary>
+        [Input("domain", required: true)]
+        public Input<string> Domain { get; set; } = null!;
+
+        /// <summary>
+        /// The ARN of the SES identity of the domain, e.g. the domainIdentityArn output of another MailUser of the domain. If provided, the identity and its DNS records are not created, so that mailboxes of one domain do not compete for them.
+        /// </summary>
+        [Input("domainIdentityArn")]
+        public Input<string>? DomainIdentityArn { get; set; }
+
+        /// <summary>
+        /// The local part of the email address.
+        /// </summary>
+        [Input("emailPrefix", required: true)]
+        public Input<string> EmailPrefix { get; set; } = null!;
+
+        /// <summary>
+        /// Whether the SMTP credentials are active. Defaults to true.
+        /// </summary>
+        [Input("enabled")]
+        public Input<bool>? Enabled { get; set; }
+
+        /// <summary>
+        /// The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+        /// </summary>
+        [Input("hostedZoneId")]
+        public Input<string>? HostedZoneId { get; set; }
+
+        /// <summary>
+        /// Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Mail can be sent once SES has verified the domain through them. Defaults to true.
+        /// </summary>
+        [Input("manageDns")]
+        public bool? ManageDns { get; set; }
+
+        /// <summary>
+        /// The name of the mailbox.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// A seed that only triggers the rotation of the SMTP credentials, changing it replaces the access key. The credentials are generated by IAM, not derived from the seed, so it is not a secret.
+        /// </summary>
+        [Input("passwordSeed")]
+        public string? PasswordSeed { get; set; }
+
+        /// <summary>
+        /// The region of the SES identity and SMTP endpoint. Defaults to the region of the stack.
+        /// </summary>
+        [Input("region")]
+        public Input<string>? Region { get; set; }
+
+        public MailUserArgs()
+        {
+        }
+        public static new MailUserArgs Empty => new MailUserArgs();
+    }
+}
@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac.Outputs
+{
+
+    /// <summary>
+    /// A DNS record to create in an external DNS provider.
+    /// </summary>
+    [OutputType]
+    public sealed class DnsRecord
+    {
+        /// <summary>
+        /// The name of the record.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The type of the record.
+        /// </summary>
+        public readonly string Type;
+        /// <summary>
+        /// The value of the record.
+        /// </summary>
+        public readonly string Value;
+
+        [OutputConstructor]
+        private DnsRecord(
+            string name,
+
+            string type,
+
+            string value)
+        {
+            Name = name;
+            Type = type;
+            Value = value;
+        }
+    }
+}
@@ -0,0 +1,96 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Gotiac
+{
+    [GotiacResourceType("gotiac:index:S3GraphStore")]
+    public partial class S3GraphStore : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The ARN of the graph store bucket.
+        /// </summary>
+        [Output("bucketArn")]
+        public Output<string> BucketArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the graph store bucket.
+        /// </summary>
+        [Output("bucketName")]
+        public Output<string> BucketName { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the role with read access to the nodes, edges and props prefixes.
+        /// </summary>
+        [Output("readerRoleArn")]
+        public Output<string> ReaderRoleArn { get; private set; } = null!;
+
+        /// <summary>
+        /// The ARN of the role with read and write access to the nodes, edges and props prefixes.
+        /// </summary>
+        [Output("writerRoleArn")]
+        public Output<string> WriterRoleArn { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a S3GraphStore resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public S3GraphStore(string name, S3GraphStoreArgs? args = null, ComponentResourceOptions? options = null)
+            : base("gotiac:index:S3GraphStore", name, args ?? new S3GraphStoreArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class S3GraphStoreArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The name of the bucket to create. If not provided, a unique name will be generated.
+        /// </summary>
+        [Input("bucketName")]
+        public Input<string>? BucketName { get; set; }
+
+        /// <summary>
+        /// Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+        /// </summary>
+        [Input("forceDestroy")]
+        public Input<bool>? ForceDestroy { get; set; }
+
+        /// <summary>
+        /// The ARN of a KMS key used to encrypt the graph. If not provided, objects are encrypted with S3 managed keys.
+        /// </summary>
+        [Input("kmsKeyArn")]
+        public Input<string>? KmsKeyArn { get; set; }
+
+        /// <summary>
+        /// The number of days noncurrent object versions are kept. Defaults to 30.
+        /// </summary>
+        [Input("noncurrentVersionExpirationDays")]
+        public Input<int>? NoncurrentVersionExpirationDays { get; set; }
+
+        public S3GraphStoreArgs()
+        {
+        }
+        public static new S3GraphStoreArgs Empty => new S3GraphStoreArgs();
+    }
+}
@@ -15,12 +15,52 @@ import (
 type FileHosting struct {
 	pulumi.ResourceState
 
-	// The ID the private key.
+	// The ARN of the bucket the files are served from.
+	BucketArn pulumi.StringOutput `pulumi:"bucketArn"`
+	// The name of the bucket the files are served from.
+	BucketName pulumi.StringOutput `pulumi:"bucketName"`
+	// The IAM policy document that allows listing and reading the files of the bucket, including decrypting them with the KMS key of the bucket if it is created with SSE-KMS or bucketKmsKeyArn is set.
+	BucketReaderPolicy pulumi.StringOutput `pulumi:"bucketReaderPolicy"`
+	// The ARN of the managed bucket reader policy. Empty unless managedPolicies is set.
+	BucketReaderPolicyArn pulumi.StringOutput `pulumi:"bucketReaderPolicyArn"`
+	// The IAM policy document that allows listing, reading, writing and deleting the files of the bucket, including using the KMS key of the bucket if it is created with SSE-KMS or bucketKmsKeyArn is set.
+	BucketWriterPolicy pulumi.StringOutput `pulumi:"bucketWriterPolicy"`
+	// The ARN of the managed bucket writer policy. Empty unless managedPolicies is set.
+	BucketWriterPolicyArn pulumi.StringOutput `pulumi:"bucketWriterPolicyArn"`
+	// The ARN of the certificate of the distribution.
+	CertificateArn pulumi.StringOutput `pulumi:"certificateArn"`
+	// The ARN of the CloudFront distribution.
+	DistributionArn pulumi.StringOutput `pulumi:"distributionArn"`
+	// The domain name of the CloudFront distribution.
+	DistributionDomainName pulumi.StringOutput `pulumi:"distributionDomainName"`
+	// The ID of the CloudFront distribution.
+	DistributionId pulumi.StringOutput `pulumi:"distributionId"`
+	// The ID of the key group trusted to sign URLs and cookies.
+	KeyGroupId pulumi.StringOutput `pulumi:"keyGroupId"`
+	// The name of the bucket the access logs are written to. Empty unless logging is configured.
+	LogBucketName pulumi.StringOutput `pulumi:"logBucketName"`
+	// The ARN of the queue the event notifications are sent to. Empty unless the component creates the queue.
+	NotificationQueueArn pulumi.StringOutput `pulumi:"notificationQueueArn"`
+	// The ARN of the topic the event notifications are published to. Empty unless the component creates the topic.
+	NotificationTopicArn pulumi.StringOutput `pulumi:"notificationTopicArn"`
+	// The ID of the origin access control CloudFront reads the bucket with.
+	OriginAccessControlId pulumi.StringOutput `pulumi:"originAccessControlId"`
+	// The ID of the public key of the active key generation.
 	PrivateKeyId pulumi.StringOutput `pulumi:"privateKeyId"`
-	// The parameter name for the private key.
+	// The parameter name for the private key of the active key generation.
 	PrivateKeyParameterName pulumi.StringOutput `pulumi:"privateKeyParameterName"`
+	// The IAM policy document that allows reading the private keys to sign URLs and cookies.
+	SignerPolicy pulumi.StringOutput `pulumi:"signerPolicy"`
+	// The ARN of the managed signer policy. Empty unless managedPolicies is set.
+	SignerPolicyArn pulumi.StringOutput `pulumi:"signerPolicyArn"`
+	// The ID of the public key of the active upload key generation. Empty unless the upload mode is signed-upload.
+	UploadPrivateKeyId pulumi.StringOutput `pulumi:"uploadPrivateKeyId"`
+	// The parameter name for the private key of the active upload key generation. Empty unless the upload mode is signed-upload.
+	UploadPrivateKeyParameterName pulumi.StringOutput `pulumi:"uploadPrivateKeyParameterName"`
 	// The file hosting URL.
 	Url pulumi.StringOutput `pulumi:"url"`
+	// The certificate validation records to create when DNS is not managed by the component.
+	ValidationRecords DnsRecordArrayOutput `pulumi:"validationRecords"`
 }
 
 // NewFileHosting registers a new resource with the given unique name, arguments, and options.
@@ -30,9 +70,6 @@ func NewFileHosting(ctx *pulumi.Context,
 		return nil, errors.New("missing one or more required arguments")
 	}
 
-	if args.Domain == nil {
-		return nil, errors.New("invalid value for required argument 'Domain'")
-	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource FileHosting
 	err := ctx.RegisterRemoteComponentResource("gotiac:index:FileHosting", name, args, &resource, opts...)
@@ -43,24 +80,304 @@ func NewFileHosting(ctx *pulumi.Context,
 }
 
 type fileHostingArgs struct {
+	// Additional domains the files are served under. They are added to the certificate as subject alternative names.
+	Aliases []string `pulumi:"aliases"`
+	// The ARN of the KMS key the existing bucket is encrypted with, if it uses SSE-KMS. It is added to the bucket reader and writer policies. The key policy has to allow the distribution and the roles to use the key. Requires bucketName.
+	BucketKmsKeyArn *string `pulumi:"bucketKmsKeyArn"`
 	// The name of an existing s3 Bucket to link as origin. If not provided, a new bucket will be created.
 	BucketName *string `pulumi:"bucketName"`
+	// The settings of the bucket that is created when no bucket name is given. Conflicts with bucketName.
+	BucketOptions *FileHostingBucket `pulumi:"bucketOptions"`
+	// How the bucket policy is written: replace overwrites the whole policy, merge keeps the statements of other systems and only adds or updates the statements of the component. Merge requires bucketName. Defaults to replace.
+	BucketPolicyMode *string `pulumi:"bucketPolicyMode"`
+	// The region of the bucket. The bucket and its policy are managed with a provider for this region. Defaults to the region of the stack.
+	BucketRegion *string `pulumi:"bucketRegion"`
+	// Additional cache behaviors for path patterns, in order of precedence. Paths that match none of the patterns use the default behavior, which requires signed URLs or cookies.
+	CacheBehaviors []FileHostingCacheBehavior `pulumi:"cacheBehaviors"`
+	// The settings of the cache policy to create. Conflicts with cachePolicyId.
+	CachePolicy *FileHostingCachePolicy `pulumi:"cachePolicy"`
+	// The ID of an existing cache policy to use instead of creating one.
+	CachePolicyId *string `pulumi:"cachePolicyId"`
+	// The ARN of an existing ACM certificate in us-east-1 covering the domain. If not provided, a new certificate will be issued and validated.
+	CertificateArn *string `pulumi:"certificateArn"`
+	// Whether the validation records of a new certificate have been created in the external DNS provider. Without managed DNS and certificateArn, deploying takes two updates: the first only creates the certificate and returns its validation records, the second, with this set, waits for the validation and creates the distribution. Defaults to false.
+	CertificateValidated *bool `pulumi:"certificateValidated"`
+	// The CORS settings of the response headers policy. If not provided, all origins are allowed.
+	Cors *FileHostingCors `pulumi:"cors"`
 	// The file hosting domain.
 	Domain string `pulumi:"domain"`
+	// The CloudFront Functions and Lambda@Edge functions of the default cache behavior. They also run on the upload behavior of the signed-upload mode.
+	EdgeHandlers *FileHostingEdgeHandlers `pulumi:"edgeHandlers"`
+	// The bucket CloudFront fails over to when the bucket returns an error. If not provided, requests are only served from the bucket.
+	Failover *FileHostingFailover `pulumi:"failover"`
+	// The countries requests are allowed from or blocked from. If not provided, requests from all countries are served.
+	GeoRestriction *FileHostingGeoRestriction `pulumi:"geoRestriction"`
+	// The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+	HostedZoneId *string `pulumi:"hostedZoneId"`
+	// Whether the distribution is reachable over IPv6. If true, AAAA records are created next to the A records. Defaults to true.
+	Ipv6Enabled *bool `pulumi:"ipv6Enabled"`
+	// The rotation of the keys trusted to sign URLs and cookies. If not provided, a single key is used.
+	KeyRotation *FileHostingKeyRotation `pulumi:"keyRotation"`
+	// The access logging settings. If not provided, no access logs are written.
+	Logging *FileHostingLogging `pulumi:"logging"`
+	// Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Defaults to true.
+	ManageDns *bool `pulumi:"manageDns"`
+	// Whether the ownership controls of the bucket are managed by the component. Defaults to true.
+	ManageOwnershipControls *bool `pulumi:"manageOwnershipControls"`
+	// Whether the public access block of the bucket is managed by the component. Defaults to true.
+	ManagePublicAccessBlock *bool `pulumi:"managePublicAccessBlock"`
+	// Whether managed IAM policies are created for the signer, bucket reader and bucket writer roles. The policy documents are returned either way. Defaults to false.
+	ManagedPolicies *bool `pulumi:"managedPolicies"`
+	// The event notifications of the bucket, e.g. to post-process uploaded files. If not provided, no notifications are configured.
+	Notifications *FileHostingNotifications `pulumi:"notifications"`
+	// The settings of the origin request policy to create. Conflicts with originRequestPolicyId.
+	OriginRequestPolicy *FileHostingOriginRequestPolicy `pulumi:"originRequestPolicy"`
+	// The ID of an existing origin request policy to use instead of creating one.
+	OriginRequestPolicyId *string `pulumi:"originRequestPolicyId"`
+	// The region of the Origin Shield requests to the buckets go through. If not provided, Origin Shield is disabled.
+	OriginShieldRegion *string `pulumi:"originShieldRegion"`
+	// The price class of the distribution: PriceClass_All, PriceClass_200 or PriceClass_100. Defaults to PriceClass_All.
+	PriceClass *string `pulumi:"priceClass"`
+	// The security headers of the response headers policy.
+	SecurityHeaders *FileHostingSecurityHeaders `pulumi:"securityHeaders"`
+	// Which writes CloudFront passes to the bucket: full allows uploads through the default behavior, signed-upload only through the upload path pattern with its own key group and read-only none at all. Defaults to full.
+	UploadMode *string `pulumi:"uploadMode"`
+	// The path pattern uploads are accepted under in signed-upload mode. Defaults to uploads/*.
+	UploadPathPattern *string `pulumi:"uploadPathPattern"`
+	// The settings of a WAF web ACL to create in us-east-1 and attach to the distribution. Conflicts with webAclArn.
+	WebAcl *WebAcl `pulumi:"webAcl"`
+	// The ARN of an existing WAF web ACL to attach to the distribution. Conflicts with webAcl.
+	WebAclArn *string `pulumi:"webAclArn"`
 }
 
 // The set of arguments for constructing a FileHosting resource.
 type FileHostingArgs struct {
+	// Additional domains the files are served under. They are added to the certificate as subject alternative names.
+	Aliases []pulumi.StringInput
+	// The ARN of the KMS key the existing bucket is encrypted with, if it uses SSE-KMS. It is added to the bucket reader and writer policies. The key policy has to allow the distribution and the roles to use the key. Requires bucketName.
+	BucketKmsKeyArn pulumi.StringPtrInput
 	// The name of an existing s3 Bucket to link as origin. If not provided, a new bucket will be created.
 	BucketName pulumi.StringPtrInput
+	// The settings of the bucket that is created when no bucket name is given. Conflicts with bucketName.
+	BucketOptions *FileHostingBucketArgs
+	// How the bucket policy is written: replace overwrites the whole policy, merge keeps the statements of other systems and only adds or updates the statements of the component. Merge requires bucketName. Defaults to replace.
+	BucketPolicyMode *string
+	// The region of the bucket. The bucket and its policy are managed with a provider for this region. Defaults to the region of the stack.
+	BucketRegion pulumi.StringPtrInput
+	// Additional cache behaviors for path patterns, in order of precedence. Paths that match none of the patterns use the default behavior, which requires signed URLs or cookies.
+	CacheBehaviors []FileHostingCacheBehaviorInput
+	// The settings of the cache policy to create. Conflicts with cachePolicyId.
+	CachePolicy *FileHostingCachePolicyArgs
+	// The ID of an existing cache policy to use instead of creating one.
+	CachePolicyId pulumi.StringPtrInput
+	// The ARN of an existing ACM certificate in us-east-1 covering the domain. If not provided, a new certificate will be issued and validated.
+	CertificateArn pulumi.StringPtrInput
+	// Whether the validation records of a new certificate have been created in the external DNS provider. Without managed DNS and certificateArn, deploying takes two updates: the first only creates the certificate and returns its validation records, the second, with this set, waits for the validation and creates the distribution. Defaults to false.
+	CertificateValidated *bool
+	// The CORS settings of the response headers policy. If not provided, all origins are allowed.
+	Cors *FileHostingCorsArgs
 	// The file hosting domain.
-	Domain pulumi.StringInput
+	Domain string
+	// The CloudFront Functions and Lambda@Edge functions of the default cache behavior. They also run on the upload behavior of the signed-upload mode.
+	EdgeHandlers *FileHostingEdgeHandlersArgs
+	// The bucket CloudFront fails over to when the bucket returns an error. If not provided, requests are only served from the bucket.
+	Failover *FileHostingFailoverArgs
+	// The countries requests are allowed from or blocked from. If not provided, requests from all countries are served.
+	GeoRestriction *FileHostingGeoRestrictionArgs
+	// The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+	HostedZoneId pulumi.StringPtrInput
+	// Whether the distribution is reachable over IPv6. If true, AAAA records are created next to the A records. Defaults to true.
+	Ipv6Enabled *bool
+	// The rotation of the keys trusted to sign URLs and cookies. If not provided, a single key is used.
+	KeyRotation *FileHostingKeyRotationArgs
+	// The access logging settings. If not provided, no access logs are written.
+	Logging *FileHostingLoggingArgs
+	// Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Defaults to true.
+	ManageDns *bool
+	// Whether the ownership controls of the bucket are managed by the component. Defaults to true.
+	ManageOwnershipControls *bool
+	// Whether the public access block of the bucket is managed by the component. Defaults to true.
+	ManagePublicAccessBlock *bool
+	// Whether managed IAM policies are created for the signer, bucket reader and bucket writer roles. The policy documents are returned either way. Defaults to false.
+	ManagedPolicies *bool
+	// The event notifications of the bucket, e.g. to post-process uploaded files. If not provided, no notifications are configured.
+	Notifications *FileHostingNotificationsArgs
+	// The settings of the origin request policy to create. Conflicts with originRequestPolicyId.
+	OriginRequestPolicy *FileHostingOriginRequestPolicyArgs
+	// The ID of an existing origin request policy to use instead of creating one.
+	OriginRequestPolicyId pulumi.StringPtrInput
+	// The region of the Origin Shield requests to the buckets go through. If not provided, Origin Shield is disabled.
+	OriginShieldRegion pulumi.StringPtrInput
+	// The price class of the distribution: PriceClass_All, PriceClass_200 or PriceClass_100. Defaults to PriceClass_All.
+	PriceClass *string
+	// The security headers of the response headers policy.
+	SecurityHeaders *FileHostingSecurityHeadersArgs
+	// Which writes CloudFront passes to the bucket: full allows uploads through the default behavior, signed-upload only through the upload path pattern with its own key group and read-only none at all. Defaults to full.
+	UploadMode *string
+	// The path pattern uploads are accepted under in signed-upload mode. Defaults to uploads/*.
+	UploadPathPattern *string
+	// The settings of a WAF web ACL to create in us-east-1 and attach to the distribution. Conflicts with webAclArn.
+	WebAcl *WebAclArgs
+	// The ARN of an existing WAF web ACL to attach to the distribution. Conflicts with webAcl.
+	WebAclArn pulumi.StringPtrInput
 }
 
 func (FileHostingArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*fileHostingArgs)(nil)).Elem()
 }
 
+// Invalidates paths of the file hosting distribution and waits until the invalidation has completed. Every update of the stack creates a new invalidation, previews create none. The invalidation is created with the credentials of the default AWS provider of the stack.
+func (r *FileHosting) Invalidate(ctx *pulumi.Context, args *FileHostingInvalidateArgs) (FileHostingInvalidateResultOutput, error) {
+	out, err := ctx.Call("gotiac:index:FileHosting/invalidate", args, FileHostingInvalidateResultOutput{}, r)
+	if err != nil {
+		return FileHostingInvalidateResultOutput{}, err
+	}
+	return out.(FileHostingInvalidateResultOutput), nil
+}
+
+type fileHostingInvalidateArgs struct {
+	// The paths to invalidate, relative to the file hosting URL. Paths start with / and may end with a * wildcard.
+	Paths []string `pulumi:"paths"`
+}
+
+// The set of arguments for the Invalidate method of the FileHosting resource.
+type FileHostingInvalidateArgs struct {
+	// The paths to invalidate, relative to the file hosting URL. Paths start with / and may end with a * wildcard.
+	Paths pulumi.StringArrayInput
+}
+
+func (FileHostingInvalidateArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*fileHostingInvalidateArgs)(nil)).Elem()
+}
+
+type FileHostingInvalidateResult struct {
+	// The ID of the invalidation. Empty in previews.
+	InvalidationId string `pulumi:"invalidationId"`
+}
+
+type FileHostingInvalidateResultOutput struct{ *pulumi.OutputState }
+
+func (FileHostingInvalidateResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingInvalidateResult)(nil)).Elem()
+}
+
+// The ID of the invalidation. Empty in previews.
+func (o FileHostingInvalidateResultOutput) InvalidationId() pulumi.StringOutput {
+	return o.ApplyT(func(v FileHostingInvalidateResult) string { return v.InvalidationId }).(pulumi.StringOutput)
+}
+
+// Creates the CloudFront signed cookies granting access to files of the file hosting.
+func (r *FileHosting) SignCookies(ctx *pulumi.Context, args *FileHostingSignCookiesArgs) (FileHostingSignCookiesResultOutput, error) {
+	out, err := ctx.Call("gotiac:index:FileHosting/signCookies", args, FileHostingSignCookiesResultOutput{}, r)
+	if err != nil {
+		return FileHostingSignCookiesResultOutput{}, err
+	}
+	return out.(FileHostingSignCookiesResultOutput), nil
+}
+
+type fileHostingSignCookiesArgs struct {
+	// The time the signature expires at, in RFC 3339 format.
+	ExpiresAt string `pulumi:"expiresAt"`
+	// The IP address or CIDR range access is restricted to. Requires a custom policy.
+	IpAddress *string `pulumi:"ipAddress"`
+	// The path of the file, relative to the file hosting URL. It is the unescaped object key, which is escaped for the URL. May contain * wildcards.
+	Path string `pulumi:"path"`
+	// The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+	StartsAt *string `pulumi:"startsAt"`
+	// Whether to sign with the upload key. Requires the signed-upload upload mode.
+	Upload *bool `pulumi:"upload"`
+}
+
+// The set of arguments for the SignCookies method of the FileHosting resource.
+type FileHostingSignCookiesArgs struct {
+	// The time the signature expires at, in RFC 3339 format.
+	ExpiresAt pulumi.StringInput
+	// The IP address or CIDR range access is restricted to. Requires a custom policy.
+	IpAddress pulumi.StringPtrInput
+	// The path of the file, relative to the file hosting URL. It is the unescaped object key, which is escaped for the URL. May contain * wildcards.
+	Path pulumi.StringInput
+	// The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+	StartsAt pulumi.StringPtrInput
+	// Whether to sign with the upload key. Requires the signed-upload upload mode.
+	Upload *bool
+}
+
+func (FileHostingSignCookiesArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*fileHostingSignCookiesArgs)(nil)).Elem()
+}
+
+type FileHostingSignCookiesResult struct {
+	// The signed cookies by name.
+	Cookies map[string]string `pulumi:"cookies"`
+}
+
+type FileHostingSignCookiesResultOutput struct{ *pulumi.OutputState }
+
+func (FileHostingSignCookiesResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingSignCookiesResult)(nil)).Elem()
+}
+
+// The signed cookies by name.
+func (o FileHostingSignCookiesResultOutput) Cookies() pulumi.StringMapOutput {
+	return o.ApplyT(func(v FileHostingSignCookiesResult) map[string]string { return v.Cookies }).(pulumi.StringMapOutput)
+}
+
+// Creates a CloudFront signed URL for a file of the file hosting.
+func (r *FileHosting) SignUrl(ctx *pulumi.Context, args *FileHostingSignUrlArgs) (FileHostingSignUrlResultOutput, error) {
+	out, err := ctx.Call("gotiac:index:FileHosting/signUrl", args, FileHostingSignUrlResultOutput{}, r)
+	if err != nil {
+		return FileHostingSignUrlResultOutput{}, err
+	}
+	return out.(FileHostingSignUrlResultOutput), nil
+}
+
+type fileHostingSignUrlArgs struct {
+	// The time the signature expires at, in RFC 3339 format.
+	ExpiresAt string `pulumi:"expiresAt"`
+	// The IP address or CIDR range access is restricted to. Requires a custom policy.
+	IpAddress *string `pulumi:"ipAddress"`
+	// The path of the file, relative to the file hosting URL. It is the unescaped object key, which is escaped for the URL.
+	Path string `pulumi:"path"`
+	// The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+	StartsAt *string `pulumi:"startsAt"`
+	// Whether to sign with the upload key. Requires the signed-upload upload mode.
+	Upload *bool `pulumi:"upload"`
+}
+
+// The set of arguments for the SignUrl method of the FileHosting resource.
+type FileHostingSignUrlArgs struct {
+	// The time the signature expires at, in RFC 3339 format.
+	ExpiresAt pulumi.StringInput
+	// The IP address or CIDR range access is restricted to. Requires a custom policy.
+	IpAddress pulumi.StringPtrInput
+	// The path of the file, relative to the file hosting URL. It is the unescaped object key, which is escaped for the URL.
+	Path pulumi.StringInput
+	// The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+	StartsAt pulumi.StringPtrInput
+	// Whether to sign with the upload key. Requires the signed-upload upload mode.
+	Upload *bool
+}
+
+func (FileHostingSignUrlArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*fileHostingSignUrlArgs)(nil)).Elem()
+}
+
+type FileHostingSignUrlResult struct {
+	// The signed URL.
+	Url string `pulumi:"url"`
+}
+
+type FileHostingSignUrlResultOutput struct{ *pulumi.OutputState }
+
+func (FileHostingSignUrlResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingSignUrlResult)(nil)).Elem()
+}
+
+// The signed URL.
+func (o FileHostingSignUrlResultOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v FileHostingSignUrlResult) string { return v.Url }).(pulumi.StringOutput)
+}
+
 type FileHostingInput interface {
 	pulumi.Input
 
@@ -144,21 +461,121 @@ func (o FileHostingOutput) ToFileHostingOutputWithContext(ctx context.Context) F
 	return o
 }
 
-// The ID the private key.
+// The ARN of the bucket the files are served from.
+func (o FileHostingOutput) BucketArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.BucketArn }).(pulumi.StringOutput)
+}
+
+// The name of the bucket the files are served from.
+func (o FileHostingOutput) BucketName() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.BucketName }).(pulumi.StringOutput)
+}
+
+// The IAM policy document that allows listing and reading the files of the bucket, including decrypting them with the KMS key of the bucket if it is created with SSE-KMS or bucketKmsKeyArn is set.
+func (o FileHostingOutput) BucketReaderPolicy() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.BucketReaderPolicy }).(pulumi.StringOutput)
+}
+
+// The ARN of the managed bucket reader policy. Empty unless managedPolicies is set.
+func (o FileHostingOutput) BucketReaderPolicyArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.BucketReaderPolicyArn }).(pulumi.StringOutput)
+}
+
+// The IAM policy document that allows listing, reading, writing and deleting the files of the bucket, including using the KMS key of the bucket if it is created with SSE-KMS or bucketKmsKeyArn is set.
+func (o FileHostingOutput) BucketWriterPolicy() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.BucketWriterPolicy }).(pulumi.StringOutput)
+}
+
+// The ARN of the managed bucket writer policy. Empty unless managedPolicies is set.
+func (o FileHostingOutput) BucketWriterPolicyArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.BucketWriterPolicyArn }).(pulumi.StringOutput)
+}
+
+// The ARN of the certificate of the distribution.
+func (o FileHostingOutput) CertificateArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.CertificateArn }).(pulumi.StringOutput)
+}
+
+// The ARN of the CloudFront distribution.
+func (o FileHostingOutput) DistributionArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.DistributionArn }).(pulumi.StringOutput)
+}
+
+// The domain name of the CloudFront distribution.
+func (o FileHostingOutput) DistributionDomainName() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.DistributionDomainName }).(pulumi.StringOutput)
+}
+
+// The ID of the CloudFront distribution.
+func (o FileHostingOutput) DistributionId() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.DistributionId }).(pulumi.StringOutput)
+}
+
+// The ID of the key group trusted to sign URLs and cookies.
+func (o FileHostingOutput) KeyGroupId() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.KeyGroupId }).(pulumi.StringOutput)
+}
+
+// The name of the bucket the access logs are written to. Empty unless logging is configured.
+func (o FileHostingOutput) LogBucketName() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.LogBucketName }).(pulumi.StringOutput)
+}
+
+// The ARN of the queue the event notifications are sent to. Empty unless the component creates the queue.
+func (o FileHostingOutput) NotificationQueueArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.NotificationQueueArn }).(pulumi.StringOutput)
+}
+
+// The ARN of the topic the event notifications are published to. Empty unless the component creates the topic.
+func (o FileHostingOutput) NotificationTopicArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.NotificationTopicArn }).(pulumi.StringOutput)
+}
+
+// The ID of the origin access control CloudFront reads the bucket with.
+func (o FileHostingOutput) OriginAccessControlId() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.OriginAccessControlId }).(pulumi.StringOutput)
+}
+
+// The ID of the public key of the active key generation.
 func (o FileHostingOutput) PrivateKeyId() pulumi.StringOutput {
 	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.PrivateKeyId }).(pulumi.StringOutput)
 }
 
-// The parameter name for the private key.
+// The parameter name for the private key of the active key generation.
 func (o FileHostingOutput) PrivateKeyParameterName() pulumi.StringOutput {
 	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.PrivateKeyParameterName }).(pulumi.StringOutput)
 }
 
+// The IAM policy document that allows reading the private keys to sign URLs and cookies.
+func (o FileHostingOutput) SignerPolicy() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.SignerPolicy }).(pulumi.StringOutput)
+}
+
+// The ARN of the managed signer policy. Empty unless managedPolicies is set.
+func (o FileHostingOutput) SignerPolicyArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.SignerPolicyArn }).(pulumi.StringOutput)
+}
+
+// The ID of the public key of the active upload key generation. Empty unless the upload mode is signed-upload.
+func (o FileHostingOutput) UploadPrivateKeyId() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.UploadPrivateKeyId }).(pulumi.StringOutput)
+}
+
+// The parameter name for the private key of the active upload key generation. Empty unless the upload mode is signed-upload.
+func (o FileHostingOutput) UploadPrivateKeyParameterName() pulumi.StringOutput {
+	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.UploadPrivateKeyParameterName }).(pulumi.StringOutput)
+}
+
 // The file hosting URL.
 func (o FileHostingOutput) Url() pulumi.StringOutput {
 	return o.ApplyT(func(v *FileHosting) pulumi.StringOutput { return v.Url }).(pulumi.StringOutput)
 }
 
+// The certificate validation records to create when DNS is not managed by the component.
+func (o FileHostingOutput) ValidationRecords() DnsRecordArrayOutput {
+	return o.ApplyT(func(v *FileHosting) DnsRecordArrayOutput { return v.ValidationRecords }).(DnsRecordArrayOutput)
+}
+
 type FileHostingArrayOutput struct{ *pulumi.OutputState }
 
 func (FileHostingArrayOutput) ElementType() reflect.Type {
@@ -204,6 +621,9 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingArrayInput)(nil)).Elem(), FileHostingArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingMapInput)(nil)).Elem(), FileHostingMap{})
 	pulumi.RegisterOutputType(FileHostingOutput{})
+	pulumi.RegisterOutputType(FileHostingInvalidateResultOutput{})
+	pulumi.RegisterOutputType(FileHostingSignCookiesResultOutput{})
+	pulumi.RegisterOutputType(FileHostingSignUrlResultOutput{})
 	pulumi.RegisterOutputType(FileHostingArrayOutput{})
 	pulumi.RegisterOutputType(FileHostingMapOutput{})
 }
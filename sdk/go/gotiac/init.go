@@ -23,6 +23,10 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 	switch typ {
 	case "gotiac:index:FileHosting":
 		r = &FileHosting{}
+	case "gotiac:index:MailUser":
+		r = &MailUser{}
+	case "gotiac:index:S3GraphStore":
+		r = &S3GraphStore{}
 	case "gotiac:index:StaticPage":
 		r = &StaticPage{}
 	default:
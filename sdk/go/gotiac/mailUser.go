@@ -0,0 +1,272 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package gotiac
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi-gotiac/sdk/go/gotiac/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type MailUser struct {
+	pulumi.ResourceState
+
+	// The SES verification and DKIM records to create when DNS is not managed by the component.
+	DnsRecords DnsRecordArrayOutput `pulumi:"dnsRecords"`
+	// The ARN of the SES identity of the domain.
+	DomainIdentityArn pulumi.StringOutput `pulumi:"domainIdentityArn"`
+	// The email address of the mailbox.
+	EmailAddress pulumi.StringOutput `pulumi:"emailAddress"`
+	// The sender address including the display name.
+	From pulumi.StringOutput `pulumi:"from"`
+	// The SES SMTP endpoint.
+	SmtpEndpoint pulumi.StringOutput `pulumi:"smtpEndpoint"`
+	// The parameter name for the SMTP password.
+	SmtpPasswordParameterName pulumi.StringOutput `pulumi:"smtpPasswordParameterName"`
+	// The parameter name for the SMTP username.
+	SmtpUsernameParameterName pulumi.StringOutput `pulumi:"smtpUsernameParameterName"`
+}
+
+// NewMailUser registers a new resource with the given unique name, arguments, and options.
+func NewMailUser(ctx *pulumi.Context,
+	name string, args *MailUserArgs, opts ...pulumi.ResourceOption) (*MailUser, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Domain == nil {
+		return nil, errors.New("invalid value for required argument 'Domain'")
+	}
+	if args.EmailPrefix == nil {
+		return nil, errors.New("invalid value for required argument 'EmailPrefix'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource MailUser
+	err := ctx.RegisterRemoteComponentResource("gotiac:index:MailUser", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type mailUserArgs struct {
+	// The display name used in the sender address.
+	DisplayName *string `pulumi:"displayName"`
+	// The mail domain.
+	Domain string `pulumi:"domain"`
+	// The ARN of the SES identity of the domain, e.g. the domainIdentityArn output of another MailUser of the domain. If provided, the identity and its DNS records are not created, so that mailboxes of one domain do not compete for them.
+	DomainIdentityArn *string `pulumi:"domainIdentityArn"`
+	// The local part of the email address.
+	EmailPrefix string `pulumi:"emailPrefix"`
+	// Whether the SMTP credentials are active. Defaults to true.
+	Enabled *bool `pulumi:"enabled"`
+	// The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+	HostedZoneId *string `pulumi:"hostedZoneId"`
+	// Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Mail can be sent once SES has verified the domain through them. Defaults to true.
+	ManageDns *bool `pulumi:"manageDns"`
+	// The name of the mailbox.
+	Name *string `pulumi:"name"`
+	// A seed that only triggers the rotation of the SMTP credentials, changing it replaces the access key. The credentials are generated by IAM, not derived from the seed, so it is not a secret.
+	PasswordSeed *string `pulumi:"passwordSeed"`
+	// The region of the SES identity and SMTP endpoint. Defaults to the region of the stack.
+	Region *string `pulumi:"region"`
+}
+
+// The set of arguments for constructing a MailUser resource.
+type MailUserArgs struct {
+	// The display name used in the sender address.
+	DisplayName pulumi.StringPtrInput
+	// The mail domain.
+	Domain pulumi.StringInput
+	// The ARN of the SES identity of the domain, e.g. the domainIdentityArn output of another MailUser of the domain. If provided, the identity and its DNS records are not created, so that mailboxes of one domain do not compete for them.
+	DomainIdentityArn pulumi.StringPtrInput
+	// The local part of the email address.
+	EmailPrefix pulumi.StringInput
+	// Whether the SMTP credentials are active. Defaults to true.
+	Enabled pulumi.BoolPtrInput
+	// The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+	HostedZoneId pulumi.StringPtrInput
+	// Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Mail can be sent once SES has verified the domain through them. Defaults to true.
+	ManageDns *bool
+	// The name of the mailbox.
+	Name pulumi.StringPtrInput
+	// A seed that only triggers the rotation of the SMTP credentials, changing it replaces the access key. The credentials are generated by IAM, not derived from the seed, so it is not a secret.
+	PasswordSeed *string
+	// The region of the SES identity and SMTP endpoint. Defaults to the region of the stack.
+	Region pulumi.StringPtrInput
+}
+
+func (MailUserArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*mailUserArgs)(nil)).Elem()
+}
+
+type MailUserInput interface {
+	pulumi.Input
+
+	ToMailUserOutput() MailUserOutput
+	ToMailUserOutputWithContext(ctx context.Context) MailUserOutput
+}
+
+func (*MailUser) ElementType() reflect.Type {
+	return reflect.TypeOf((**MailUser)(nil)).Elem()
+}
+
+func (i *MailUser) ToMailUserOutput() MailUserOutput {
+	return i.ToMailUserOutputWithContext(context.Background())
+}
+
+func (i *MailUser) ToMailUserOutputWithContext(ctx context.Context) MailUserOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MailUserOutput)
+}
+
+// MailUserArrayInput is an input type that accepts MailUserArray and MailUserArrayOutput values.
+// You can construct a concrete instance of `MailUserArrayInput` via:
+//
+//	MailUserArray{ MailUserArgs{...} }
+type MailUserArrayInput interface {
+	pulumi.Input
+
+	ToMailUserArrayOutput() MailUserArrayOutput
+	ToMailUserArrayOutputWithContext(context.Context) MailUserArrayOutput
+}
+
+type MailUserArray []MailUserInput
+
+func (MailUserArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*MailUser)(nil)).Elem()
+}
+
+func (i MailUserArray) ToMailUserArrayOutput() MailUserArrayOutput {
+	return i.ToMailUserArrayOutputWithContext(context.Background())
+}
+
+func (i MailUserArray) ToMailUserArrayOutputWithContext(ctx context.Context) MailUserArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MailUserArrayOutput)
+}
+
+// MailUserMapInput is an input type that accepts MailUserMap and MailUserMapOutput values.
+// You can construct a concrete instance of `MailUserMapInput` via:
+//
+//	MailUserMap{ "key": MailUserArgs{...} }
+type MailUserMapInput interface {
+	pulumi.Input
+
+	ToMailUserMapOutput() MailUserMapOutput
+	ToMailUserMapOutputWithContext(context.Context) MailUserMapOutput
+}
+
+type MailUserMap map[string]MailUserInput
+
+func (MailUserMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*MailUser)(nil)).Elem()
+}
+
+func (i MailUserMap) ToMailUserMapOutput() MailUserMapOutput {
+	return i.ToMailUserMapOutputWithContext(context.Background())
+}
+
+func (i MailUserMap) ToMailUserMapOutputWithContext(ctx context.Context) MailUserMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MailUserMapOutput)
+}
+
+type MailUserOutput struct{ *pulumi.OutputState }
+
+func (MailUserOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**MailUser)(nil)).Elem()
+}
+
+func (o MailUserOutput) ToMailUserOutput() MailUserOutput {
+	return o
+}
+
+func (o MailUserOutput) ToMailUserOutputWithContext(ctx context.Context) MailUserOutput {
+	return o
+}
+
+// The SES verification and DKIM records to create when DNS is not managed by the component.
+func (o MailUserOutput) DnsRecords() DnsRecordArrayOutput {
+	return o.ApplyT(func(v *MailUser) DnsRecordArrayOutput { return v.DnsRecords }).(DnsRecordArrayOutput)
+}
+
+// The ARN of the SES identity of the domain.
+func (o MailUserOutput) DomainIdentityArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *MailUser) pulumi.StringOutput { return v.DomainIdentityArn }).(pulumi.StringOutput)
+}
+
+// The email address of the mailbox.
+func (o MailUserOutput) EmailAddress() pulumi.StringOutput {
+	return o.ApplyT(func(v *MailUser) pulumi.StringOutput { return v.EmailAddress }).(pulumi.StringOutput)
+}
+
+// The sender address including the display name.
+func (o MailUserOutput) From() pulumi.StringOutput {
+	return o.ApplyT(func(v *MailUser) pulumi.StringOutput { return v.From }).(pulumi.StringOutput)
+}
+
+// The SES SMTP endpoint.
+func (o MailUserOutput) SmtpEndpoint() pulumi.StringOutput {
+	return o.ApplyT(func(v *MailUser) pulumi.StringOutput { return v.SmtpEndpoint }).(pulumi.StringOutput)
+}
+
+// The parameter name for the SMTP password.
+func (o MailUserOutput) SmtpPasswordParameterName() pulumi.StringOutput {
+	return o.ApplyT(func(v *MailUser) pulumi.StringOutput { return v.SmtpPasswordParameterName }).(pulumi.StringOutput)
+}
+
+// The parameter name for the SMTP username.
+func (o MailUserOutput) SmtpUsernameParameterName() pulumi.StringOutput {
+	return o.ApplyT(func(v *MailUser) pulumi.StringOutput { return v.SmtpUsernameParameterName }).(pulumi.StringOutput)
+}
+
+type MailUserArrayOutput struct{ *pulumi.OutputState }
+
+func (MailUserArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*MailUser)(nil)).Elem()
+}
+
+func (o MailUserArrayOutput) ToMailUserArrayOutput() MailUserArrayOutput {
+	return o
+}
+
+func (o MailUserArrayOutput) ToMailUserArrayOutputWithContext(ctx context.Context) MailUserArrayOutput {
+	return o
+}
+
+func (o MailUserArrayOutput) Index(i pulumi.IntInput) MailUserOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *MailUser {
+		return vs[0].([]*MailUser)[vs[1].(int)]
+	}).(MailUserOutput)
+}
+
+type MailUserMapOutput struct{ *pulumi.OutputState }
+
+func (MailUserMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*MailUser)(nil)).Elem()
+}
+
+func (o MailUserMapOutput) ToMailUserMapOutput() MailUserMapOutput {
+	return o
+}
+
+func (o MailUserMapOutput) ToMailUserMapOutputWithContext(ctx context.Context) MailUserMapOutput {
+	return o
+}
+
+func (o MailUserMapOutput) MapIndex(k pulumi.StringInput) MailUserOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *MailUser {
+		return vs[0].(map[string]*MailUser)[vs[1].(string)]
+	}).(MailUserOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*MailUserInput)(nil)).Elem(), &MailUser{})
+	pulumi.RegisterInputType(reflect.TypeOf((*MailUserArrayInput)(nil)).Elem(), MailUserArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*MailUserMapInput)(nil)).Elem(), MailUserMap{})
+	pulumi.RegisterOutputType(MailUserOutput{})
+	pulumi.RegisterOutputType(MailUserArrayOutput{})
+	pulumi.RegisterOutputType(MailUserMapOutput{})
+}
@@ -0,0 +1,3236 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package gotiac
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-gotiac/sdk/go/gotiac/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+var _ = internal.GetEnvOrDefault
+
+// A DNS record to create in an external DNS provider.
+type DnsRecord struct {
+	// The name of the record.
+	Name string `pulumi:"name"`
+	// The type of the record.
+	Type string `pulumi:"type"`
+	// The value of the record.
+	Value string `pulumi:"value"`
+}
+
+// A DNS record to create in an external DNS provider.
+type DnsRecordOutput struct{ *pulumi.OutputState }
+
+func (DnsRecordOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DnsRecord)(nil)).Elem()
+}
+
+func (o DnsRecordOutput) ToDnsRecordOutput() DnsRecordOutput {
+	return o
+}
+
+func (o DnsRecordOutput) ToDnsRecordOutputWithContext(ctx context.Context) DnsRecordOutput {
+	return o
+}
+
+// The name of the record.
+func (o DnsRecordOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v DnsRecord) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The type of the record.
+func (o DnsRecordOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v DnsRecord) string { return v.Type }).(pulumi.StringOutput)
+}
+
+// The value of the record.
+func (o DnsRecordOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v DnsRecord) string { return v.Value }).(pulumi.StringOutput)
+}
+
+type DnsRecordArrayOutput struct{ *pulumi.OutputState }
+
+func (DnsRecordArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]DnsRecord)(nil)).Elem()
+}
+
+func (o DnsRecordArrayOutput) ToDnsRecordArrayOutput() DnsRecordArrayOutput {
+	return o
+}
+
+func (o DnsRecordArrayOutput) ToDnsRecordArrayOutputWithContext(ctx context.Context) DnsRecordArrayOutput {
+	return o
+}
+
+func (o DnsRecordArrayOutput) Index(i pulumi.IntInput) DnsRecordOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) DnsRecord {
+		return vs[0].([]DnsRecord)[vs[1].(int)]
+	}).(DnsRecordOutput)
+}
+
+// The settings of the bucket a FileHosting creates when no bucket name is given.
+type FileHostingBucket struct {
+	// The number of days after which incomplete multipart uploads are aborted. Defaults to 7, 0 disables the rule.
+	AbortIncompleteMultipartUploadDays *int `pulumi:"abortIncompleteMultipartUploadDays"`
+	// The server side encryption of the bucket: SSE-S3 or SSE-KMS. Defaults to SSE-S3.
+	Encryption *string `pulumi:"encryption"`
+	// Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+	ForceDestroy *bool `pulumi:"forceDestroy"`
+	// The ARN of the KMS key used with SSE-KMS. Its key policy has to allow CloudFront to use it. If not provided, a key is generated.
+	KmsKeyArn *string `pulumi:"kmsKeyArn"`
+	// The lifecycle rules of the bucket.
+	LifecycleRules []FileHostingLifecycleRule `pulumi:"lifecycleRules"`
+	// Whether the bucket is protected from being deleted by Pulumi. Defaults to false.
+	Protect *bool `pulumi:"protect"`
+	// Whether the bucket is kept in AWS when it is deleted from the stack. Defaults to false.
+	RetainOnDelete *bool `pulumi:"retainOnDelete"`
+	// Whether versioning is enabled. Defaults to false.
+	Versioning *bool `pulumi:"versioning"`
+}
+
+// FileHostingBucketInput is an input type that accepts FileHostingBucketArgs and FileHostingBucketOutput values.
+// You can construct a concrete instance of `FileHostingBucketInput` via:
+//
+//	FileHostingBucketArgs{...}
+type FileHostingBucketInput interface {
+	pulumi.Input
+
+	ToFileHostingBucketOutput() FileHostingBucketOutput
+	ToFileHostingBucketOutputWithContext(context.Context) FileHostingBucketOutput
+}
+
+// The settings of the bucket a FileHosting creates when no bucket name is given.
+type FileHostingBucketArgs struct {
+	// The number of days after which incomplete multipart uploads are aborted. Defaults to 7, 0 disables the rule.
+	AbortIncompleteMultipartUploadDays *int `pulumi:"abortIncompleteMultipartUploadDays"`
+	// The server side encryption of the bucket: SSE-S3 or SSE-KMS. Defaults to SSE-S3.
+	Encryption *string `pulumi:"encryption"`
+	// Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+	ForceDestroy *bool `pulumi:"forceDestroy"`
+	// The ARN of the KMS key used with SSE-KMS. Its key policy has to allow CloudFront to use it. If not provided, a key is generated.
+	KmsKeyArn pulumi.StringPtrInput `pulumi:"kmsKeyArn"`
+	// The lifecycle rules of the bucket.
+	LifecycleRules []FileHostingLifecycleRuleInput `pulumi:"lifecycleRules"`
+	// Whether the bucket is protected from being deleted by Pulumi. Defaults to false.
+	Protect *bool `pulumi:"protect"`
+	// Whether the bucket is kept in AWS when it is deleted from the stack. Defaults to false.
+	RetainOnDelete *bool `pulumi:"retainOnDelete"`
+	// Whether versioning is enabled. Defaults to false.
+	Versioning *bool `pulumi:"versioning"`
+}
+
+func (FileHostingBucketArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingBucket)(nil)).Elem()
+}
+
+func (i FileHostingBucketArgs) ToFileHostingBucketOutput() FileHostingBucketOutput {
+	return i.ToFileHostingBucketOutputWithContext(context.Background())
+}
+
+func (i FileHostingBucketArgs) ToFileHostingBucketOutputWithContext(ctx context.Context) FileHostingBucketOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingBucketOutput)
+}
+
+func (i FileHostingBucketArgs) ToFileHostingBucketPtrOutput() FileHostingBucketPtrOutput {
+	return i.ToFileHostingBucketPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingBucketArgs) ToFileHostingBucketPtrOutputWithContext(ctx context.Context) FileHostingBucketPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingBucketOutput).ToFileHostingBucketPtrOutputWithContext(ctx)
+}
+
+// FileHostingBucketPtrInput is an input type that accepts FileHostingBucketArgs, FileHostingBucketPtr and FileHostingBucketPtrOutput values.
+// You can construct a concrete instance of `FileHostingBucketPtrInput` via:
+//
+//	        FileHostingBucketArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingBucketPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingBucketPtrOutput() FileHostingBucketPtrOutput
+	ToFileHostingBucketPtrOutputWithContext(context.Context) FileHostingBucketPtrOutput
+}
+
+type fileHostingBucketPtrType FileHostingBucketArgs
+
+func FileHostingBucketPtr(v *FileHostingBucketArgs) FileHostingBucketPtrInput {
+	return (*fileHostingBucketPtrType)(v)
+}
+
+func (*fileHostingBucketPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingBucket)(nil)).Elem()
+}
+
+func (i *fileHostingBucketPtrType) ToFileHostingBucketPtrOutput() FileHostingBucketPtrOutput {
+	return i.ToFileHostingBucketPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingBucketPtrType) ToFileHostingBucketPtrOutputWithContext(ctx context.Context) FileHostingBucketPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingBucketPtrOutput)
+}
+
+// The settings of the bucket a FileHosting creates when no bucket name is given.
+type FileHostingBucketOutput struct{ *pulumi.OutputState }
+
+func (FileHostingBucketOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingBucket)(nil)).Elem()
+}
+
+func (o FileHostingBucketOutput) ToFileHostingBucketOutput() FileHostingBucketOutput {
+	return o
+}
+
+func (o FileHostingBucketOutput) ToFileHostingBucketOutputWithContext(ctx context.Context) FileHostingBucketOutput {
+	return o
+}
+
+func (o FileHostingBucketOutput) ToFileHostingBucketPtrOutput() FileHostingBucketPtrOutput {
+	return o.ToFileHostingBucketPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingBucketOutput) ToFileHostingBucketPtrOutputWithContext(ctx context.Context) FileHostingBucketPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingBucket) *FileHostingBucket {
+		return &v
+	}).(FileHostingBucketPtrOutput)
+}
+
+// The number of days after which incomplete multipart uploads are aborted. Defaults to 7, 0 disables the rule.
+func (o FileHostingBucketOutput) AbortIncompleteMultipartUploadDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingBucket) *int { return v.AbortIncompleteMultipartUploadDays }).(pulumi.IntPtrOutput)
+}
+
+// The server side encryption of the bucket: SSE-S3 or SSE-KMS. Defaults to SSE-S3.
+func (o FileHostingBucketOutput) Encryption() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingBucket) *string { return v.Encryption }).(pulumi.StringPtrOutput)
+}
+
+// Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+func (o FileHostingBucketOutput) ForceDestroy() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingBucket) *bool { return v.ForceDestroy }).(pulumi.BoolPtrOutput)
+}
+
+// The ARN of the KMS key used with SSE-KMS. Its key policy has to allow CloudFront to use it. If not provided, a key is generated.
+func (o FileHostingBucketOutput) KmsKeyArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingBucket) *string { return v.KmsKeyArn }).(pulumi.StringPtrOutput)
+}
+
+// The lifecycle rules of the bucket.
+func (o FileHostingBucketOutput) LifecycleRules() FileHostingLifecycleRuleArrayOutput {
+	return o.ApplyT(func(v FileHostingBucket) []FileHostingLifecycleRule { return v.LifecycleRules }).(FileHostingLifecycleRuleArrayOutput)
+}
+
+// Whether the bucket is protected from being deleted by Pulumi. Defaults to false.
+func (o FileHostingBucketOutput) Protect() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingBucket) *bool { return v.Protect }).(pulumi.BoolPtrOutput)
+}
+
+// Whether the bucket is kept in AWS when it is deleted from the stack. Defaults to false.
+func (o FileHostingBucketOutput) RetainOnDelete() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingBucket) *bool { return v.RetainOnDelete }).(pulumi.BoolPtrOutput)
+}
+
+// Whether versioning is enabled. Defaults to false.
+func (o FileHostingBucketOutput) Versioning() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingBucket) *bool { return v.Versioning }).(pulumi.BoolPtrOutput)
+}
+
+type FileHostingBucketPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingBucketPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingBucket)(nil)).Elem()
+}
+
+func (o FileHostingBucketPtrOutput) ToFileHostingBucketPtrOutput() FileHostingBucketPtrOutput {
+	return o
+}
+
+func (o FileHostingBucketPtrOutput) ToFileHostingBucketPtrOutputWithContext(ctx context.Context) FileHostingBucketPtrOutput {
+	return o
+}
+
+func (o FileHostingBucketPtrOutput) Elem() FileHostingBucketOutput {
+	return o.ApplyT(func(v *FileHostingBucket) FileHostingBucket {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingBucket
+		return ret
+	}).(FileHostingBucketOutput)
+}
+
+// The number of days after which incomplete multipart uploads are aborted. Defaults to 7, 0 disables the rule.
+func (o FileHostingBucketPtrOutput) AbortIncompleteMultipartUploadDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *FileHostingBucket) *int {
+		if v == nil {
+			return nil
+		}
+		return v.AbortIncompleteMultipartUploadDays
+	}).(pulumi.IntPtrOutput)
+}
+
+// The server side encryption of the bucket: SSE-S3 or SSE-KMS. Defaults to SSE-S3.
+func (o FileHostingBucketPtrOutput) Encryption() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingBucket) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Encryption
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+func (o FileHostingBucketPtrOutput) ForceDestroy() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingBucket) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ForceDestroy
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The ARN of the KMS key used with SSE-KMS. Its key policy has to allow CloudFront to use it. If not provided, a key is generated.
+func (o FileHostingBucketPtrOutput) KmsKeyArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingBucket) *string {
+		if v == nil {
+			return nil
+		}
+		return v.KmsKeyArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// The lifecycle rules of the bucket.
+func (o FileHostingBucketPtrOutput) LifecycleRules() FileHostingLifecycleRuleArrayOutput {
+	return o.ApplyT(func(v *FileHostingBucket) []FileHostingLifecycleRule {
+		if v == nil {
+			return nil
+		}
+		return v.LifecycleRules
+	}).(FileHostingLifecycleRuleArrayOutput)
+}
+
+// Whether the bucket is protected from being deleted by Pulumi. Defaults to false.
+func (o FileHostingBucketPtrOutput) Protect() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingBucket) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Protect
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether the bucket is kept in AWS when it is deleted from the stack. Defaults to false.
+func (o FileHostingBucketPtrOutput) RetainOnDelete() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingBucket) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.RetainOnDelete
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether versioning is enabled. Defaults to false.
+func (o FileHostingBucketPtrOutput) Versioning() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingBucket) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Versioning
+	}).(pulumi.BoolPtrOutput)
+}
+
+// A cache behavior of a FileHosting for a path pattern.
+type FileHostingCacheBehavior struct {
+	// The HTTP methods CloudFront processes and forwards to the bucket. Defaults to GET, HEAD and OPTIONS. With failover, a behavior allowing other methods targets the bucket directly.
+	AllowedMethods []string `pulumi:"allowedMethods"`
+	// The ID of the cache policy of the behavior. Defaults to the cache policy of the component.
+	CachePolicyId *string `pulumi:"cachePolicyId"`
+	// The CloudFront Functions and Lambda@Edge functions of the behavior.
+	EdgeHandlers *FileHostingEdgeHandlers `pulumi:"edgeHandlers"`
+	// The path pattern the behavior applies to, e.g. public/*.
+	PathPattern string `pulumi:"pathPattern"`
+	// Whether requests require signed URLs or cookies. Defaults to true.
+	Signed *bool `pulumi:"signed"`
+}
+
+// FileHostingCacheBehaviorInput is an input type that accepts FileHostingCacheBehaviorArgs and FileHostingCacheBehaviorOutput values.
+// You can construct a concrete instance of `FileHostingCacheBehaviorInput` via:
+//
+//	FileHostingCacheBehaviorArgs{...}
+type FileHostingCacheBehaviorInput interface {
+	pulumi.Input
+
+	ToFileHostingCacheBehaviorOutput() FileHostingCacheBehaviorOutput
+	ToFileHostingCacheBehaviorOutputWithContext(context.Context) FileHostingCacheBehaviorOutput
+}
+
+// A cache behavior of a FileHosting for a path pattern.
+type FileHostingCacheBehaviorArgs struct {
+	// The HTTP methods CloudFront processes and forwards to the bucket. Defaults to GET, HEAD and OPTIONS. With failover, a behavior allowing other methods targets the bucket directly.
+	AllowedMethods pulumi.StringArrayInput `pulumi:"allowedMethods"`
+	// The ID of the cache policy of the behavior. Defaults to the cache policy of the component.
+	CachePolicyId pulumi.StringPtrInput `pulumi:"cachePolicyId"`
+	// The CloudFront Functions and Lambda@Edge functions of the behavior.
+	EdgeHandlers *FileHostingEdgeHandlersArgs `pulumi:"edgeHandlers"`
+	// The path pattern the behavior applies to, e.g. public/*.
+	PathPattern pulumi.StringInput `pulumi:"pathPattern"`
+	// Whether requests require signed URLs or cookies. Defaults to true.
+	Signed *bool `pulumi:"signed"`
+}
+
+func (FileHostingCacheBehaviorArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingCacheBehavior)(nil)).Elem()
+}
+
+func (i FileHostingCacheBehaviorArgs) ToFileHostingCacheBehaviorOutput() FileHostingCacheBehaviorOutput {
+	return i.ToFileHostingCacheBehaviorOutputWithContext(context.Background())
+}
+
+func (i FileHostingCacheBehaviorArgs) ToFileHostingCacheBehaviorOutputWithContext(ctx context.Context) FileHostingCacheBehaviorOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingCacheBehaviorOutput)
+}
+
+// FileHostingCacheBehaviorArrayInput is an input type that accepts FileHostingCacheBehaviorArray and FileHostingCacheBehaviorArrayOutput values.
+// You can construct a concrete instance of `FileHostingCacheBehaviorArrayInput` via:
+//
+//	FileHostingCacheBehaviorArray{ FileHostingCacheBehaviorArgs{...} }
+type FileHostingCacheBehaviorArrayInput interface {
+	pulumi.Input
+
+	ToFileHostingCacheBehaviorArrayOutput() FileHostingCacheBehaviorArrayOutput
+	ToFileHostingCacheBehaviorArrayOutputWithContext(context.Context) FileHostingCacheBehaviorArrayOutput
+}
+
+type FileHostingCacheBehaviorArray []FileHostingCacheBehaviorInput
+
+func (FileHostingCacheBehaviorArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]FileHostingCacheBehavior)(nil)).Elem()
+}
+
+func (i FileHostingCacheBehaviorArray) ToFileHostingCacheBehaviorArrayOutput() FileHostingCacheBehaviorArrayOutput {
+	return i.ToFileHostingCacheBehaviorArrayOutputWithContext(context.Background())
+}
+
+func (i FileHostingCacheBehaviorArray) ToFileHostingCacheBehaviorArrayOutputWithContext(ctx context.Context) FileHostingCacheBehaviorArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingCacheBehaviorArrayOutput)
+}
+
+// A cache behavior of a FileHosting for a path pattern.
+type FileHostingCacheBehaviorOutput struct{ *pulumi.OutputState }
+
+func (FileHostingCacheBehaviorOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingCacheBehavior)(nil)).Elem()
+}
+
+func (o FileHostingCacheBehaviorOutput) ToFileHostingCacheBehaviorOutput() FileHostingCacheBehaviorOutput {
+	return o
+}
+
+func (o FileHostingCacheBehaviorOutput) ToFileHostingCacheBehaviorOutputWithContext(ctx context.Context) FileHostingCacheBehaviorOutput {
+	return o
+}
+
+// The HTTP methods CloudFront processes and forwards to the bucket. Defaults to GET, HEAD and OPTIONS. With failover, a behavior allowing other methods targets the bucket directly.
+func (o FileHostingCacheBehaviorOutput) AllowedMethods() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingCacheBehavior) []string { return v.AllowedMethods }).(pulumi.StringArrayOutput)
+}
+
+// The ID of the cache policy of the behavior. Defaults to the cache policy of the component.
+func (o FileHostingCacheBehaviorOutput) CachePolicyId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingCacheBehavior) *string { return v.CachePolicyId }).(pulumi.StringPtrOutput)
+}
+
+// The CloudFront Functions and Lambda@Edge functions of the behavior.
+func (o FileHostingCacheBehaviorOutput) EdgeHandlers() FileHostingEdgeHandlersPtrOutput {
+	return o.ApplyT(func(v FileHostingCacheBehavior) *FileHostingEdgeHandlers { return v.EdgeHandlers }).(FileHostingEdgeHandlersPtrOutput)
+}
+
+// The path pattern the behavior applies to, e.g. public/*.
+func (o FileHostingCacheBehaviorOutput) PathPattern() pulumi.StringOutput {
+	return o.ApplyT(func(v FileHostingCacheBehavior) string { return v.PathPattern }).(pulumi.StringOutput)
+}
+
+// Whether requests require signed URLs or cookies. Defaults to true.
+func (o FileHostingCacheBehaviorOutput) Signed() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingCacheBehavior) *bool { return v.Signed }).(pulumi.BoolPtrOutput)
+}
+
+type FileHostingCacheBehaviorArrayOutput struct{ *pulumi.OutputState }
+
+func (FileHostingCacheBehaviorArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]FileHostingCacheBehavior)(nil)).Elem()
+}
+
+func (o FileHostingCacheBehaviorArrayOutput) ToFileHostingCacheBehaviorArrayOutput() FileHostingCacheBehaviorArrayOutput {
+	return o
+}
+
+func (o FileHostingCacheBehaviorArrayOutput) ToFileHostingCacheBehaviorArrayOutputWithContext(ctx context.Context) FileHostingCacheBehaviorArrayOutput {
+	return o
+}
+
+func (o FileHostingCacheBehaviorArrayOutput) Index(i pulumi.IntInput) FileHostingCacheBehaviorOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) FileHostingCacheBehavior {
+		return vs[0].([]FileHostingCacheBehavior)[vs[1].(int)]
+	}).(FileHostingCacheBehaviorOutput)
+}
+
+// The settings of the cache policy a FileHosting creates.
+type FileHostingCachePolicy struct {
+	// Which cookies are part of the cache key: none, whitelist, allExcept or all. Defaults to none.
+	CookieBehavior *string `pulumi:"cookieBehavior"`
+	// The cookies the cookie behavior applies to.
+	Cookies []string `pulumi:"cookies"`
+	// The default time objects stay in the cache, in seconds. Defaults to 86400.
+	DefaultTtl *int `pulumi:"defaultTtl"`
+	// Whether brotli compressed objects are cached and served. Defaults to false.
+	EnableAcceptEncodingBrotli *bool `pulumi:"enableAcceptEncodingBrotli"`
+	// Whether gzip compressed objects are cached and served. Defaults to false.
+	EnableAcceptEncodingGzip *bool `pulumi:"enableAcceptEncodingGzip"`
+	// Which headers are part of the cache key: none or whitelist. Defaults to none.
+	HeaderBehavior *string `pulumi:"headerBehavior"`
+	// The headers the header behavior applies to.
+	Headers []string `pulumi:"headers"`
+	// The maximum time objects stay in the cache, in seconds. Defaults to 31536000.
+	MaxTtl *int `pulumi:"maxTtl"`
+	// The minimum time objects stay in the cache, in seconds. Defaults to 1.
+	MinTtl *int `pulumi:"minTtl"`
+	// Which query strings are part of the cache key: none, whitelist, allExcept or all. Defaults to whitelist.
+	QueryStringBehavior *string `pulumi:"queryStringBehavior"`
+	// The query strings the query string behavior applies to. Defaults to etag.
+	QueryStrings []string `pulumi:"queryStrings"`
+}
+
+// FileHostingCachePolicyInput is an input type that accepts FileHostingCachePolicyArgs and FileHostingCachePolicyOutput values.
+// You can construct a concrete instance of `FileHostingCachePolicyInput` via:
+//
+//	FileHostingCachePolicyArgs{...}
+type FileHostingCachePolicyInput interface {
+	pulumi.Input
+
+	ToFileHostingCachePolicyOutput() FileHostingCachePolicyOutput
+	ToFileHostingCachePolicyOutputWithContext(context.Context) FileHostingCachePolicyOutput
+}
+
+// The settings of the cache policy a FileHosting creates.
+type FileHostingCachePolicyArgs struct {
+	// Which cookies are part of the cache key: none, whitelist, allExcept or all. Defaults to none.
+	CookieBehavior pulumi.StringPtrInput `pulumi:"cookieBehavior"`
+	// The cookies the cookie behavior applies to.
+	Cookies pulumi.StringArrayInput `pulumi:"cookies"`
+	// The default time objects stay in the cache, in seconds. Defaults to 86400.
+	DefaultTtl pulumi.IntPtrInput `pulumi:"defaultTtl"`
+	// Whether brotli compressed objects are cached and served. Defaults to false.
+	EnableAcceptEncodingBrotli pulumi.BoolPtrInput `pulumi:"enableAcceptEncodingBrotli"`
+	// Whether gzip compressed objects are cached and served. Defaults to false.
+	EnableAcceptEncodingGzip pulumi.BoolPtrInput `pulumi:"enableAcceptEncodingGzip"`
+	// Which headers are part of the cache key: none or whitelist. Defaults to none.
+	HeaderBehavior pulumi.StringPtrInput `pulumi:"headerBehavior"`
+	// The headers the header behavior applies to.
+	Headers pulumi.StringArrayInput `pulumi:"headers"`
+	// The maximum time objects stay in the cache, in seconds. Defaults to 31536000.
+	MaxTtl pulumi.IntPtrInput `pulumi:"maxTtl"`
+	// The minimum time objects stay in the cache, in seconds. Defaults to 1.
+	MinTtl pulumi.IntPtrInput `pulumi:"minTtl"`
+	// Which query strings are part of the cache key: none, whitelist, allExcept or all. Defaults to whitelist.
+	QueryStringBehavior pulumi.StringPtrInput `pulumi:"queryStringBehavior"`
+	// The query strings the query string behavior applies to. Defaults to etag.
+	QueryStrings pulumi.StringArrayInput `pulumi:"queryStrings"`
+}
+
+func (FileHostingCachePolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingCachePolicy)(nil)).Elem()
+}
+
+func (i FileHostingCachePolicyArgs) ToFileHostingCachePolicyOutput() FileHostingCachePolicyOutput {
+	return i.ToFileHostingCachePolicyOutputWithContext(context.Background())
+}
+
+func (i FileHostingCachePolicyArgs) ToFileHostingCachePolicyOutputWithContext(ctx context.Context) FileHostingCachePolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingCachePolicyOutput)
+}
+
+func (i FileHostingCachePolicyArgs) ToFileHostingCachePolicyPtrOutput() FileHostingCachePolicyPtrOutput {
+	return i.ToFileHostingCachePolicyPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingCachePolicyArgs) ToFileHostingCachePolicyPtrOutputWithContext(ctx context.Context) FileHostingCachePolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingCachePolicyOutput).ToFileHostingCachePolicyPtrOutputWithContext(ctx)
+}
+
+// FileHostingCachePolicyPtrInput is an input type that accepts FileHostingCachePolicyArgs, FileHostingCachePolicyPtr and FileHostingCachePolicyPtrOutput values.
+// You can construct a concrete instance of `FileHostingCachePolicyPtrInput` via:
+//
+//	        FileHostingCachePolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingCachePolicyPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingCachePolicyPtrOutput() FileHostingCachePolicyPtrOutput
+	ToFileHostingCachePolicyPtrOutputWithContext(context.Context) FileHostingCachePolicyPtrOutput
+}
+
+type fileHostingCachePolicyPtrType FileHostingCachePolicyArgs
+
+func FileHostingCachePolicyPtr(v *FileHostingCachePolicyArgs) FileHostingCachePolicyPtrInput {
+	return (*fileHostingCachePolicyPtrType)(v)
+}
+
+func (*fileHostingCachePolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingCachePolicy)(nil)).Elem()
+}
+
+func (i *fileHostingCachePolicyPtrType) ToFileHostingCachePolicyPtrOutput() FileHostingCachePolicyPtrOutput {
+	return i.ToFileHostingCachePolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingCachePolicyPtrType) ToFileHostingCachePolicyPtrOutputWithContext(ctx context.Context) FileHostingCachePolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingCachePolicyPtrOutput)
+}
+
+// The settings of the cache policy a FileHosting creates.
+type FileHostingCachePolicyOutput struct{ *pulumi.OutputState }
+
+func (FileHostingCachePolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingCachePolicy)(nil)).Elem()
+}
+
+func (o FileHostingCachePolicyOutput) ToFileHostingCachePolicyOutput() FileHostingCachePolicyOutput {
+	return o
+}
+
+func (o FileHostingCachePolicyOutput) ToFileHostingCachePolicyOutputWithContext(ctx context.Context) FileHostingCachePolicyOutput {
+	return o
+}
+
+func (o FileHostingCachePolicyOutput) ToFileHostingCachePolicyPtrOutput() FileHostingCachePolicyPtrOutput {
+	return o.ToFileHostingCachePolicyPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingCachePolicyOutput) ToFileHostingCachePolicyPtrOutputWithContext(ctx context.Context) FileHostingCachePolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingCachePolicy) *FileHostingCachePolicy {
+		return &v
+	}).(FileHostingCachePolicyPtrOutput)
+}
+
+// Which cookies are part of the cache key: none, whitelist, allExcept or all. Defaults to none.
+func (o FileHostingCachePolicyOutput) CookieBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) *string { return v.CookieBehavior }).(pulumi.StringPtrOutput)
+}
+
+// The cookies the cookie behavior applies to.
+func (o FileHostingCachePolicyOutput) Cookies() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) []string { return v.Cookies }).(pulumi.StringArrayOutput)
+}
+
+// The default time objects stay in the cache, in seconds. Defaults to 86400.
+func (o FileHostingCachePolicyOutput) DefaultTtl() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) *int { return v.DefaultTtl }).(pulumi.IntPtrOutput)
+}
+
+// Whether brotli compressed objects are cached and served. Defaults to false.
+func (o FileHostingCachePolicyOutput) EnableAcceptEncodingBrotli() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) *bool { return v.EnableAcceptEncodingBrotli }).(pulumi.BoolPtrOutput)
+}
+
+// Whether gzip compressed objects are cached and served. Defaults to false.
+func (o FileHostingCachePolicyOutput) EnableAcceptEncodingGzip() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) *bool { return v.EnableAcceptEncodingGzip }).(pulumi.BoolPtrOutput)
+}
+
+// Which headers are part of the cache key: none or whitelist. Defaults to none.
+func (o FileHostingCachePolicyOutput) HeaderBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) *string { return v.HeaderBehavior }).(pulumi.StringPtrOutput)
+}
+
+// The headers the header behavior applies to.
+func (o FileHostingCachePolicyOutput) Headers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) []string { return v.Headers }).(pulumi.StringArrayOutput)
+}
+
+// The maximum time objects stay in the cache, in seconds. Defaults to 31536000.
+func (o FileHostingCachePolicyOutput) MaxTtl() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) *int { return v.MaxTtl }).(pulumi.IntPtrOutput)
+}
+
+// The minimum time objects stay in the cache, in seconds. Defaults to 1.
+func (o FileHostingCachePolicyOutput) MinTtl() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) *int { return v.MinTtl }).(pulumi.IntPtrOutput)
+}
+
+// Which query strings are part of the cache key: none, whitelist, allExcept or all. Defaults to whitelist.
+func (o FileHostingCachePolicyOutput) QueryStringBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) *string { return v.QueryStringBehavior }).(pulumi.StringPtrOutput)
+}
+
+// The query strings the query string behavior applies to. Defaults to etag.
+func (o FileHostingCachePolicyOutput) QueryStrings() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingCachePolicy) []string { return v.QueryStrings }).(pulumi.StringArrayOutput)
+}
+
+type FileHostingCachePolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingCachePolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingCachePolicy)(nil)).Elem()
+}
+
+func (o FileHostingCachePolicyPtrOutput) ToFileHostingCachePolicyPtrOutput() FileHostingCachePolicyPtrOutput {
+	return o
+}
+
+func (o FileHostingCachePolicyPtrOutput) ToFileHostingCachePolicyPtrOutputWithContext(ctx context.Context) FileHostingCachePolicyPtrOutput {
+	return o
+}
+
+func (o FileHostingCachePolicyPtrOutput) Elem() FileHostingCachePolicyOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) FileHostingCachePolicy {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingCachePolicy
+		return ret
+	}).(FileHostingCachePolicyOutput)
+}
+
+// Which cookies are part of the cache key: none, whitelist, allExcept or all. Defaults to none.
+func (o FileHostingCachePolicyPtrOutput) CookieBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.CookieBehavior
+	}).(pulumi.StringPtrOutput)
+}
+
+// The cookies the cookie behavior applies to.
+func (o FileHostingCachePolicyPtrOutput) Cookies() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Cookies
+	}).(pulumi.StringArrayOutput)
+}
+
+// The default time objects stay in the cache, in seconds. Defaults to 86400.
+func (o FileHostingCachePolicyPtrOutput) DefaultTtl() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) *int {
+		if v == nil {
+			return nil
+		}
+		return v.DefaultTtl
+	}).(pulumi.IntPtrOutput)
+}
+
+// Whether brotli compressed objects are cached and served. Defaults to false.
+func (o FileHostingCachePolicyPtrOutput) EnableAcceptEncodingBrotli() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EnableAcceptEncodingBrotli
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether gzip compressed objects are cached and served. Defaults to false.
+func (o FileHostingCachePolicyPtrOutput) EnableAcceptEncodingGzip() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EnableAcceptEncodingGzip
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Which headers are part of the cache key: none or whitelist. Defaults to none.
+func (o FileHostingCachePolicyPtrOutput) HeaderBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.HeaderBehavior
+	}).(pulumi.StringPtrOutput)
+}
+
+// The headers the header behavior applies to.
+func (o FileHostingCachePolicyPtrOutput) Headers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Headers
+	}).(pulumi.StringArrayOutput)
+}
+
+// The maximum time objects stay in the cache, in seconds. Defaults to 31536000.
+func (o FileHostingCachePolicyPtrOutput) MaxTtl() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MaxTtl
+	}).(pulumi.IntPtrOutput)
+}
+
+// The minimum time objects stay in the cache, in seconds. Defaults to 1.
+func (o FileHostingCachePolicyPtrOutput) MinTtl() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MinTtl
+	}).(pulumi.IntPtrOutput)
+}
+
+// Which query strings are part of the cache key: none, whitelist, allExcept or all. Defaults to whitelist.
+func (o FileHostingCachePolicyPtrOutput) QueryStringBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.QueryStringBehavior
+	}).(pulumi.StringPtrOutput)
+}
+
+// The query strings the query string behavior applies to. Defaults to etag.
+func (o FileHostingCachePolicyPtrOutput) QueryStrings() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingCachePolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.QueryStrings
+	}).(pulumi.StringArrayOutput)
+}
+
+// The CORS settings of the response headers policy of a FileHosting.
+type FileHostingCors struct {
+	// Whether cross-origin requests may include credentials. Requires explicit allowed origins. Defaults to false.
+	AllowCredentials *bool `pulumi:"allowCredentials"`
+	// The headers allowed in cross-origin requests. Defaults to all headers.
+	AllowedHeaders []string `pulumi:"allowedHeaders"`
+	// The HTTP methods allowed in cross-origin requests. Defaults to ALL.
+	AllowedMethods []string `pulumi:"allowedMethods"`
+	// The origins allowed to access the files. Defaults to all origins.
+	AllowedOrigins []string `pulumi:"allowedOrigins"`
+	// The headers exposed to cross-origin responses. Defaults to ETag, which multipart uploads need.
+	ExposeHeaders []string `pulumi:"exposeHeaders"`
+	// The time browsers may cache preflight responses, in seconds. Defaults to 600.
+	MaxAgeSeconds *int `pulumi:"maxAgeSeconds"`
+}
+
+// FileHostingCorsInput is an input type that accepts FileHostingCorsArgs and FileHostingCorsOutput values.
+// You can construct a concrete instance of `FileHostingCorsInput` via:
+//
+//	FileHostingCorsArgs{...}
+type FileHostingCorsInput interface {
+	pulumi.Input
+
+	ToFileHostingCorsOutput() FileHostingCorsOutput
+	ToFileHostingCorsOutputWithContext(context.Context) FileHostingCorsOutput
+}
+
+// The CORS settings of the response headers policy of a FileHosting.
+type FileHostingCorsArgs struct {
+	// Whether cross-origin requests may include credentials. Requires explicit allowed origins. Defaults to false.
+	AllowCredentials *bool `pulumi:"allowCredentials"`
+	// The headers allowed in cross-origin requests. Defaults to all headers.
+	AllowedHeaders pulumi.StringArrayInput `pulumi:"allowedHeaders"`
+	// The HTTP methods allowed in cross-origin requests. Defaults to ALL.
+	AllowedMethods pulumi.StringArrayInput `pulumi:"allowedMethods"`
+	// The origins allowed to access the files. Defaults to all origins.
+	AllowedOrigins []pulumi.StringInput `pulumi:"allowedOrigins"`
+	// The headers exposed to cross-origin responses. Defaults to ETag, which multipart uploads need.
+	ExposeHeaders pulumi.StringArrayInput `pulumi:"exposeHeaders"`
+	// The time browsers may cache preflight responses, in seconds. Defaults to 600.
+	MaxAgeSeconds pulumi.IntPtrInput `pulumi:"maxAgeSeconds"`
+}
+
+func (FileHostingCorsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingCors)(nil)).Elem()
+}
+
+func (i FileHostingCorsArgs) ToFileHostingCorsOutput() FileHostingCorsOutput {
+	return i.ToFileHostingCorsOutputWithContext(context.Background())
+}
+
+func (i FileHostingCorsArgs) ToFileHostingCorsOutputWithContext(ctx context.Context) FileHostingCorsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingCorsOutput)
+}
+
+func (i FileHostingCorsArgs) ToFileHostingCorsPtrOutput() FileHostingCorsPtrOutput {
+	return i.ToFileHostingCorsPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingCorsArgs) ToFileHostingCorsPtrOutputWithContext(ctx context.Context) FileHostingCorsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingCorsOutput).ToFileHostingCorsPtrOutputWithContext(ctx)
+}
+
+// FileHostingCorsPtrInput is an input type that accepts FileHostingCorsArgs, FileHostingCorsPtr and FileHostingCorsPtrOutput values.
+// You can construct a concrete instance of `FileHostingCorsPtrInput` via:
+//
+//	        FileHostingCorsArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingCorsPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingCorsPtrOutput() FileHostingCorsPtrOutput
+	ToFileHostingCorsPtrOutputWithContext(context.Context) FileHostingCorsPtrOutput
+}
+
+type fileHostingCorsPtrType FileHostingCorsArgs
+
+func FileHostingCorsPtr(v *FileHostingCorsArgs) FileHostingCorsPtrInput {
+	return (*fileHostingCorsPtrType)(v)
+}
+
+func (*fileHostingCorsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingCors)(nil)).Elem()
+}
+
+func (i *fileHostingCorsPtrType) ToFileHostingCorsPtrOutput() FileHostingCorsPtrOutput {
+	return i.ToFileHostingCorsPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingCorsPtrType) ToFileHostingCorsPtrOutputWithContext(ctx context.Context) FileHostingCorsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingCorsPtrOutput)
+}
+
+// The CORS settings of the response headers policy of a FileHosting.
+type FileHostingCorsOutput struct{ *pulumi.OutputState }
+
+func (FileHostingCorsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingCors)(nil)).Elem()
+}
+
+func (o FileHostingCorsOutput) ToFileHostingCorsOutput() FileHostingCorsOutput {
+	return o
+}
+
+func (o FileHostingCorsOutput) ToFileHostingCorsOutputWithContext(ctx context.Context) FileHostingCorsOutput {
+	return o
+}
+
+func (o FileHostingCorsOutput) ToFileHostingCorsPtrOutput() FileHostingCorsPtrOutput {
+	return o.ToFileHostingCorsPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingCorsOutput) ToFileHostingCorsPtrOutputWithContext(ctx context.Context) FileHostingCorsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingCors) *FileHostingCors {
+		return &v
+	}).(FileHostingCorsPtrOutput)
+}
+
+// Whether cross-origin requests may include credentials. Requires explicit allowed origins. Defaults to false.
+func (o FileHostingCorsOutput) AllowCredentials() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingCors) *bool { return v.AllowCredentials }).(pulumi.BoolPtrOutput)
+}
+
+// The headers allowed in cross-origin requests. Defaults to all headers.
+func (o FileHostingCorsOutput) AllowedHeaders() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingCors) []string { return v.AllowedHeaders }).(pulumi.StringArrayOutput)
+}
+
+// The HTTP methods allowed in cross-origin requests. Defaults to ALL.
+func (o FileHostingCorsOutput) AllowedMethods() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingCors) []string { return v.AllowedMethods }).(pulumi.StringArrayOutput)
+}
+
+// The origins allowed to access the files. Defaults to all origins.
+func (o FileHostingCorsOutput) AllowedOrigins() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingCors) []string { return v.AllowedOrigins }).(pulumi.StringArrayOutput)
+}
+
+// The headers exposed to cross-origin responses. Defaults to ETag, which multipart uploads need.
+func (o FileHostingCorsOutput) ExposeHeaders() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingCors) []string { return v.ExposeHeaders }).(pulumi.StringArrayOutput)
+}
+
+// The time browsers may cache preflight responses, in seconds. Defaults to 600.
+func (o FileHostingCorsOutput) MaxAgeSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingCors) *int { return v.MaxAgeSeconds }).(pulumi.IntPtrOutput)
+}
+
+type FileHostingCorsPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingCorsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingCors)(nil)).Elem()
+}
+
+func (o FileHostingCorsPtrOutput) ToFileHostingCorsPtrOutput() FileHostingCorsPtrOutput {
+	return o
+}
+
+func (o FileHostingCorsPtrOutput) ToFileHostingCorsPtrOutputWithContext(ctx context.Context) FileHostingCorsPtrOutput {
+	return o
+}
+
+func (o FileHostingCorsPtrOutput) Elem() FileHostingCorsOutput {
+	return o.ApplyT(func(v *FileHostingCors) FileHostingCors {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingCors
+		return ret
+	}).(FileHostingCorsOutput)
+}
+
+// Whether cross-origin requests may include credentials. Requires explicit allowed origins. Defaults to false.
+func (o FileHostingCorsPtrOutput) AllowCredentials() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingCors) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.AllowCredentials
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The headers allowed in cross-origin requests. Defaults to all headers.
+func (o FileHostingCorsPtrOutput) AllowedHeaders() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingCors) []string {
+		if v == nil {
+			return nil
+		}
+		return v.AllowedHeaders
+	}).(pulumi.StringArrayOutput)
+}
+
+// The HTTP methods allowed in cross-origin requests. Defaults to ALL.
+func (o FileHostingCorsPtrOutput) AllowedMethods() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingCors) []string {
+		if v == nil {
+			return nil
+		}
+		return v.AllowedMethods
+	}).(pulumi.StringArrayOutput)
+}
+
+// The origins allowed to access the files. Defaults to all origins.
+func (o FileHostingCorsPtrOutput) AllowedOrigins() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingCors) []string {
+		if v == nil {
+			return nil
+		}
+		return v.AllowedOrigins
+	}).(pulumi.StringArrayOutput)
+}
+
+// The headers exposed to cross-origin responses. Defaults to ETag, which multipart uploads need.
+func (o FileHostingCorsPtrOutput) ExposeHeaders() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingCors) []string {
+		if v == nil {
+			return nil
+		}
+		return v.ExposeHeaders
+	}).(pulumi.StringArrayOutput)
+}
+
+// The time browsers may cache preflight responses, in seconds. Defaults to 600.
+func (o FileHostingCorsPtrOutput) MaxAgeSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *FileHostingCors) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MaxAgeSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// The edge handlers of a cache behavior of a FileHosting. Each event type takes either a CloudFront Function or a Lambda@Edge function.
+type FileHostingEdgeHandlers struct {
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin requests.
+	OriginRequestLambdaArn *string `pulumi:"originRequestLambdaArn"`
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin responses, e.g. to set the Content-Disposition header before the response is cached.
+	OriginResponseLambdaArn *string `pulumi:"originResponseLambdaArn"`
+	// The code of a CloudFront Function run on viewer requests, e.g. to rewrite paths or check tokens. The function is created and published with the cloudfront-js-2.0 runtime.
+	ViewerRequestFunction *string `pulumi:"viewerRequestFunction"`
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer requests.
+	ViewerRequestLambdaArn *string `pulumi:"viewerRequestLambdaArn"`
+	// The code of a CloudFront Function run on viewer responses, e.g. to set headers.
+	ViewerResponseFunction *string `pulumi:"viewerResponseFunction"`
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer responses.
+	ViewerResponseLambdaArn *string `pulumi:"viewerResponseLambdaArn"`
+}
+
+// FileHostingEdgeHandlersInput is an input type that accepts FileHostingEdgeHandlersArgs and FileHostingEdgeHandlersOutput values.
+// You can construct a concrete instance of `FileHostingEdgeHandlersInput` via:
+//
+//	FileHostingEdgeHandlersArgs{...}
+type FileHostingEdgeHandlersInput interface {
+	pulumi.Input
+
+	ToFileHostingEdgeHandlersOutput() FileHostingEdgeHandlersOutput
+	ToFileHostingEdgeHandlersOutputWithContext(context.Context) FileHostingEdgeHandlersOutput
+}
+
+// The edge handlers of a cache behavior of a FileHosting. Each event type takes either a CloudFront Function or a Lambda@Edge function.
+type FileHostingEdgeHandlersArgs struct {
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin requests.
+	OriginRequestLambdaArn pulumi.StringPtrInput `pulumi:"originRequestLambdaArn"`
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin responses, e.g. to set the Content-Disposition header before the response is cached.
+	OriginResponseLambdaArn pulumi.StringPtrInput `pulumi:"originResponseLambdaArn"`
+	// The code of a CloudFront Function run on viewer requests, e.g. to rewrite paths or check tokens. The function is created and published with the cloudfront-js-2.0 runtime.
+	ViewerRequestFunction pulumi.StringPtrInput `pulumi:"viewerRequestFunction"`
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer requests.
+	ViewerRequestLambdaArn pulumi.StringPtrInput `pulumi:"viewerRequestLambdaArn"`
+	// The code of a CloudFront Function run on viewer responses, e.g. to set headers.
+	ViewerResponseFunction pulumi.StringPtrInput `pulumi:"viewerResponseFunction"`
+	// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer responses.
+	ViewerResponseLambdaArn pulumi.StringPtrInput `pulumi:"viewerResponseLambdaArn"`
+}
+
+func (FileHostingEdgeHandlersArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingEdgeHandlers)(nil)).Elem()
+}
+
+func (i FileHostingEdgeHandlersArgs) ToFileHostingEdgeHandlersOutput() FileHostingEdgeHandlersOutput {
+	return i.ToFileHostingEdgeHandlersOutputWithContext(context.Background())
+}
+
+func (i FileHostingEdgeHandlersArgs) ToFileHostingEdgeHandlersOutputWithContext(ctx context.Context) FileHostingEdgeHandlersOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingEdgeHandlersOutput)
+}
+
+func (i FileHostingEdgeHandlersArgs) ToFileHostingEdgeHandlersPtrOutput() FileHostingEdgeHandlersPtrOutput {
+	return i.ToFileHostingEdgeHandlersPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingEdgeHandlersArgs) ToFileHostingEdgeHandlersPtrOutputWithContext(ctx context.Context) FileHostingEdgeHandlersPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingEdgeHandlersOutput).ToFileHostingEdgeHandlersPtrOutputWithContext(ctx)
+}
+
+// FileHostingEdgeHandlersPtrInput is an input type that accepts FileHostingEdgeHandlersArgs, FileHostingEdgeHandlersPtr and FileHostingEdgeHandlersPtrOutput values.
+// You can construct a concrete instance of `FileHostingEdgeHandlersPtrInput` via:
+//
+//	        FileHostingEdgeHandlersArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingEdgeHandlersPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingEdgeHandlersPtrOutput() FileHostingEdgeHandlersPtrOutput
+	ToFileHostingEdgeHandlersPtrOutputWithContext(context.Context) FileHostingEdgeHandlersPtrOutput
+}
+
+type fileHostingEdgeHandlersPtrType FileHostingEdgeHandlersArgs
+
+func FileHostingEdgeHandlersPtr(v *FileHostingEdgeHandlersArgs) FileHostingEdgeHandlersPtrInput {
+	return (*fileHostingEdgeHandlersPtrType)(v)
+}
+
+func (*fileHostingEdgeHandlersPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingEdgeHandlers)(nil)).Elem()
+}
+
+func (i *fileHostingEdgeHandlersPtrType) ToFileHostingEdgeHandlersPtrOutput() FileHostingEdgeHandlersPtrOutput {
+	return i.ToFileHostingEdgeHandlersPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingEdgeHandlersPtrType) ToFileHostingEdgeHandlersPtrOutputWithContext(ctx context.Context) FileHostingEdgeHandlersPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingEdgeHandlersPtrOutput)
+}
+
+// The edge handlers of a cache behavior of a FileHosting. Each event type takes either a CloudFront Function or a Lambda@Edge function.
+type FileHostingEdgeHandlersOutput struct{ *pulumi.OutputState }
+
+func (FileHostingEdgeHandlersOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingEdgeHandlers)(nil)).Elem()
+}
+
+func (o FileHostingEdgeHandlersOutput) ToFileHostingEdgeHandlersOutput() FileHostingEdgeHandlersOutput {
+	return o
+}
+
+func (o FileHostingEdgeHandlersOutput) ToFileHostingEdgeHandlersOutputWithContext(ctx context.Context) FileHostingEdgeHandlersOutput {
+	return o
+}
+
+func (o FileHostingEdgeHandlersOutput) ToFileHostingEdgeHandlersPtrOutput() FileHostingEdgeHandlersPtrOutput {
+	return o.ToFileHostingEdgeHandlersPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingEdgeHandlersOutput) ToFileHostingEdgeHandlersPtrOutputWithContext(ctx context.Context) FileHostingEdgeHandlersPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingEdgeHandlers) *FileHostingEdgeHandlers {
+		return &v
+	}).(FileHostingEdgeHandlersPtrOutput)
+}
+
+// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin requests.
+func (o FileHostingEdgeHandlersOutput) OriginRequestLambdaArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingEdgeHandlers) *string { return v.OriginRequestLambdaArn }).(pulumi.StringPtrOutput)
+}
+
+// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin responses, e.g. to set the Content-Disposition header before the response is cached.
+func (o FileHostingEdgeHandlersOutput) OriginResponseLambdaArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingEdgeHandlers) *string { return v.OriginResponseLambdaArn }).(pulumi.StringPtrOutput)
+}
+
+// The code of a CloudFront Function run on viewer requests, e.g. to rewrite paths or check tokens. The function is created and published with the cloudfront-js-2.0 runtime.
+func (o FileHostingEdgeHandlersOutput) ViewerRequestFunction() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingEdgeHandlers) *string { return v.ViewerRequestFunction }).(pulumi.StringPtrOutput)
+}
+
+// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer requests.
+func (o FileHostingEdgeHandlersOutput) ViewerRequestLambdaArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingEdgeHandlers) *string { return v.ViewerRequestLambdaArn }).(pulumi.StringPtrOutput)
+}
+
+// The code of a CloudFront Function run on viewer responses, e.g. to set headers.
+func (o FileHostingEdgeHandlersOutput) ViewerResponseFunction() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingEdgeHandlers) *string { return v.ViewerResponseFunction }).(pulumi.StringPtrOutput)
+}
+
+// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer responses.
+func (o FileHostingEdgeHandlersOutput) ViewerResponseLambdaArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingEdgeHandlers) *string { return v.ViewerResponseLambdaArn }).(pulumi.StringPtrOutput)
+}
+
+type FileHostingEdgeHandlersPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingEdgeHandlersPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingEdgeHandlers)(nil)).Elem()
+}
+
+func (o FileHostingEdgeHandlersPtrOutput) ToFileHostingEdgeHandlersPtrOutput() FileHostingEdgeHandlersPtrOutput {
+	return o
+}
+
+func (o FileHostingEdgeHandlersPtrOutput) ToFileHostingEdgeHandlersPtrOutputWithContext(ctx context.Context) FileHostingEdgeHandlersPtrOutput {
+	return o
+}
+
+func (o FileHostingEdgeHandlersPtrOutput) Elem() FileHostingEdgeHandlersOutput {
+	return o.ApplyT(func(v *FileHostingEdgeHandlers) FileHostingEdgeHandlers {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingEdgeHandlers
+		return ret
+	}).(FileHostingEdgeHandlersOutput)
+}
+
+// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin requests.
+func (o FileHostingEdgeHandlersPtrOutput) OriginRequestLambdaArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingEdgeHandlers) *string {
+		if v == nil {
+			return nil
+		}
+		return v.OriginRequestLambdaArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin responses, e.g. to set the Content-Disposition header before the response is cached.
+func (o FileHostingEdgeHandlersPtrOutput) OriginResponseLambdaArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingEdgeHandlers) *string {
+		if v == nil {
+			return nil
+		}
+		return v.OriginResponseLambdaArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// The code of a CloudFront Function run on viewer requests, e.g. to rewrite paths or check tokens. The function is created and published with the cloudfront-js-2.0 runtime.
+func (o FileHostingEdgeHandlersPtrOutput) ViewerRequestFunction() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingEdgeHandlers) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ViewerRequestFunction
+	}).(pulumi.StringPtrOutput)
+}
+
+// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer requests.
+func (o FileHostingEdgeHandlersPtrOutput) ViewerRequestLambdaArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingEdgeHandlers) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ViewerRequestLambdaArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// The code of a CloudFront Function run on viewer responses, e.g. to set headers.
+func (o FileHostingEdgeHandlersPtrOutput) ViewerResponseFunction() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingEdgeHandlers) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ViewerResponseFunction
+	}).(pulumi.StringPtrOutput)
+}
+
+// The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer responses.
+func (o FileHostingEdgeHandlersPtrOutput) ViewerResponseLambdaArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingEdgeHandlers) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ViewerResponseLambdaArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// The bucket a FileHosting fails over to.
+type FileHostingFailover struct {
+	// The name of an existing bucket to fail over to. If not provided, a bucket is created.
+	BucketName *string `pulumi:"bucketName"`
+	// The region of the failover bucket. Defaults to the region of the stack.
+	BucketRegion *string `pulumi:"bucketRegion"`
+	// Whether the objects of the bucket are replicated to the failover bucket. Both buckets need versioning enabled. Defaults to false.
+	Replication *bool `pulumi:"replication"`
+	// The status codes of the bucket on which CloudFront retries the request with the failover bucket. Defaults to 500, 502, 503, 504, 403 and 404.
+	StatusCodes []int `pulumi:"statusCodes"`
+}
+
+// FileHostingFailoverInput is an input type that accepts FileHostingFailoverArgs and FileHostingFailoverOutput values.
+// You can construct a concrete instance of `FileHostingFailoverInput` via:
+//
+//	FileHostingFailoverArgs{...}
+type FileHostingFailoverInput interface {
+	pulumi.Input
+
+	ToFileHostingFailoverOutput() FileHostingFailoverOutput
+	ToFileHostingFailoverOutputWithContext(context.Context) FileHostingFailoverOutput
+}
+
+// The bucket a FileHosting fails over to.
+type FileHostingFailoverArgs struct {
+	// The name of an existing bucket to fail over to. If not provided, a bucket is created.
+	BucketName pulumi.StringPtrInput `pulumi:"bucketName"`
+	// The region of the failover bucket. Defaults to the region of the stack.
+	BucketRegion pulumi.StringPtrInput `pulumi:"bucketRegion"`
+	// Whether the objects of the bucket are replicated to the failover bucket. Both buckets need versioning enabled. Defaults to false.
+	Replication *bool `pulumi:"replication"`
+	// The status codes of the bucket on which CloudFront retries the request with the failover bucket. Defaults to 500, 502, 503, 504, 403 and 404.
+	StatusCodes []pulumi.IntInput `pulumi:"statusCodes"`
+}
+
+func (FileHostingFailoverArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingFailover)(nil)).Elem()
+}
+
+func (i FileHostingFailoverArgs) ToFileHostingFailoverOutput() FileHostingFailoverOutput {
+	return i.ToFileHostingFailoverOutputWithContext(context.Background())
+}
+
+func (i FileHostingFailoverArgs) ToFileHostingFailoverOutputWithContext(ctx context.Context) FileHostingFailoverOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingFailoverOutput)
+}
+
+func (i FileHostingFailoverArgs) ToFileHostingFailoverPtrOutput() FileHostingFailoverPtrOutput {
+	return i.ToFileHostingFailoverPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingFailoverArgs) ToFileHostingFailoverPtrOutputWithContext(ctx context.Context) FileHostingFailoverPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingFailoverOutput).ToFileHostingFailoverPtrOutputWithContext(ctx)
+}
+
+// FileHostingFailoverPtrInput is an input type that accepts FileHostingFailoverArgs, FileHostingFailoverPtr and FileHostingFailoverPtrOutput values.
+// You can construct a concrete instance of `FileHostingFailoverPtrInput` via:
+//
+//	        FileHostingFailoverArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingFailoverPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingFailoverPtrOutput() FileHostingFailoverPtrOutput
+	ToFileHostingFailoverPtrOutputWithContext(context.Context) FileHostingFailoverPtrOutput
+}
+
+type fileHostingFailoverPtrType FileHostingFailoverArgs
+
+func FileHostingFailoverPtr(v *FileHostingFailoverArgs) FileHostingFailoverPtrInput {
+	return (*fileHostingFailoverPtrType)(v)
+}
+
+func (*fileHostingFailoverPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingFailover)(nil)).Elem()
+}
+
+func (i *fileHostingFailoverPtrType) ToFileHostingFailoverPtrOutput() FileHostingFailoverPtrOutput {
+	return i.ToFileHostingFailoverPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingFailoverPtrType) ToFileHostingFailoverPtrOutputWithContext(ctx context.Context) FileHostingFailoverPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingFailoverPtrOutput)
+}
+
+// The bucket a FileHosting fails over to.
+type FileHostingFailoverOutput struct{ *pulumi.OutputState }
+
+func (FileHostingFailoverOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingFailover)(nil)).Elem()
+}
+
+func (o FileHostingFailoverOutput) ToFileHostingFailoverOutput() FileHostingFailoverOutput {
+	return o
+}
+
+func (o FileHostingFailoverOutput) ToFileHostingFailoverOutputWithContext(ctx context.Context) FileHostingFailoverOutput {
+	return o
+}
+
+func (o FileHostingFailoverOutput) ToFileHostingFailoverPtrOutput() FileHostingFailoverPtrOutput {
+	return o.ToFileHostingFailoverPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingFailoverOutput) ToFileHostingFailoverPtrOutputWithContext(ctx context.Context) FileHostingFailoverPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingFailover) *FileHostingFailover {
+		return &v
+	}).(FileHostingFailoverPtrOutput)
+}
+
+// The name of an existing bucket to fail over to. If not provided, a bucket is created.
+func (o FileHostingFailoverOutput) BucketName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingFailover) *string { return v.BucketName }).(pulumi.StringPtrOutput)
+}
+
+// The region of the failover bucket. Defaults to the region of the stack.
+func (o FileHostingFailoverOutput) BucketRegion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingFailover) *string { return v.BucketRegion }).(pulumi.StringPtrOutput)
+}
+
+// Whether the objects of the bucket are replicated to the failover bucket. Both buckets need versioning enabled. Defaults to false.
+func (o FileHostingFailoverOutput) Replication() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingFailover) *bool { return v.Replication }).(pulumi.BoolPtrOutput)
+}
+
+// The status codes of the bucket on which CloudFront retries the request with the failover bucket. Defaults to 500, 502, 503, 504, 403 and 404.
+func (o FileHostingFailoverOutput) StatusCodes() pulumi.IntArrayOutput {
+	return o.ApplyT(func(v FileHostingFailover) []int { return v.StatusCodes }).(pulumi.IntArrayOutput)
+}
+
+type FileHostingFailoverPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingFailoverPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingFailover)(nil)).Elem()
+}
+
+func (o FileHostingFailoverPtrOutput) ToFileHostingFailoverPtrOutput() FileHostingFailoverPtrOutput {
+	return o
+}
+
+func (o FileHostingFailoverPtrOutput) ToFileHostingFailoverPtrOutputWithContext(ctx context.Context) FileHostingFailoverPtrOutput {
+	return o
+}
+
+func (o FileHostingFailoverPtrOutput) Elem() FileHostingFailoverOutput {
+	return o.ApplyT(func(v *FileHostingFailover) FileHostingFailover {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingFailover
+		return ret
+	}).(FileHostingFailoverOutput)
+}
+
+// The name of an existing bucket to fail over to. If not provided, a bucket is created.
+func (o FileHostingFailoverPtrOutput) BucketName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingFailover) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BucketName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The region of the failover bucket. Defaults to the region of the stack.
+func (o FileHostingFailoverPtrOutput) BucketRegion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingFailover) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BucketRegion
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether the objects of the bucket are replicated to the failover bucket. Both buckets need versioning enabled. Defaults to false.
+func (o FileHostingFailoverPtrOutput) Replication() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingFailover) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Replication
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The status codes of the bucket on which CloudFront retries the request with the failover bucket. Defaults to 500, 502, 503, 504, 403 and 404.
+func (o FileHostingFailoverPtrOutput) StatusCodes() pulumi.IntArrayOutput {
+	return o.ApplyT(func(v *FileHostingFailover) []int {
+		if v == nil {
+			return nil
+		}
+		return v.StatusCodes
+	}).(pulumi.IntArrayOutput)
+}
+
+// The geo restriction of a FileHosting.
+type FileHostingGeoRestriction struct {
+	// The ISO 3166-1 alpha-2 codes of the countries the restriction type applies to.
+	Locations []string `pulumi:"locations"`
+	// How the locations are applied: whitelist only allows requests from them, blacklist blocks them and none disables the restriction.
+	RestrictionType string `pulumi:"restrictionType"`
+}
+
+// FileHostingGeoRestrictionInput is an input type that accepts FileHostingGeoRestrictionArgs and FileHostingGeoRestrictionOutput values.
+// You can construct a concrete instance of `FileHostingGeoRestrictionInput` via:
+//
+//	FileHostingGeoRestrictionArgs{...}
+type FileHostingGeoRestrictionInput interface {
+	pulumi.Input
+
+	ToFileHostingGeoRestrictionOutput() FileHostingGeoRestrictionOutput
+	ToFileHostingGeoRestrictionOutputWithContext(context.Context) FileHostingGeoRestrictionOutput
+}
+
+// The geo restriction of a FileHosting.
+type FileHostingGeoRestrictionArgs struct {
+	// The ISO 3166-1 alpha-2 codes of the countries the restriction type applies to.
+	Locations []pulumi.StringInput `pulumi:"locations"`
+	// How the locations are applied: whitelist only allows requests from them, blacklist blocks them and none disables the restriction.
+	RestrictionType string `pulumi:"restrictionType"`
+}
+
+func (FileHostingGeoRestrictionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingGeoRestriction)(nil)).Elem()
+}
+
+func (i FileHostingGeoRestrictionArgs) ToFileHostingGeoRestrictionOutput() FileHostingGeoRestrictionOutput {
+	return i.ToFileHostingGeoRestrictionOutputWithContext(context.Background())
+}
+
+func (i FileHostingGeoRestrictionArgs) ToFileHostingGeoRestrictionOutputWithContext(ctx context.Context) FileHostingGeoRestrictionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingGeoRestrictionOutput)
+}
+
+func (i FileHostingGeoRestrictionArgs) ToFileHostingGeoRestrictionPtrOutput() FileHostingGeoRestrictionPtrOutput {
+	return i.ToFileHostingGeoRestrictionPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingGeoRestrictionArgs) ToFileHostingGeoRestrictionPtrOutputWithContext(ctx context.Context) FileHostingGeoRestrictionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingGeoRestrictionOutput).ToFileHostingGeoRestrictionPtrOutputWithContext(ctx)
+}
+
+// FileHostingGeoRestrictionPtrInput is an input type that accepts FileHostingGeoRestrictionArgs, FileHostingGeoRestrictionPtr and FileHostingGeoRestrictionPtrOutput values.
+// You can construct a concrete instance of `FileHostingGeoRestrictionPtrInput` via:
+//
+//	        FileHostingGeoRestrictionArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingGeoRestrictionPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingGeoRestrictionPtrOutput() FileHostingGeoRestrictionPtrOutput
+	ToFileHostingGeoRestrictionPtrOutputWithContext(context.Context) FileHostingGeoRestrictionPtrOutput
+}
+
+type fileHostingGeoRestrictionPtrType FileHostingGeoRestrictionArgs
+
+func FileHostingGeoRestrictionPtr(v *FileHostingGeoRestrictionArgs) FileHostingGeoRestrictionPtrInput {
+	return (*fileHostingGeoRestrictionPtrType)(v)
+}
+
+func (*fileHostingGeoRestrictionPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingGeoRestriction)(nil)).Elem()
+}
+
+func (i *fileHostingGeoRestrictionPtrType) ToFileHostingGeoRestrictionPtrOutput() FileHostingGeoRestrictionPtrOutput {
+	return i.ToFileHostingGeoRestrictionPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingGeoRestrictionPtrType) ToFileHostingGeoRestrictionPtrOutputWithContext(ctx context.Context) FileHostingGeoRestrictionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingGeoRestrictionPtrOutput)
+}
+
+// The geo restriction of a FileHosting.
+type FileHostingGeoRestrictionOutput struct{ *pulumi.OutputState }
+
+func (FileHostingGeoRestrictionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingGeoRestriction)(nil)).Elem()
+}
+
+func (o FileHostingGeoRestrictionOutput) ToFileHostingGeoRestrictionOutput() FileHostingGeoRestrictionOutput {
+	return o
+}
+
+func (o FileHostingGeoRestrictionOutput) ToFileHostingGeoRestrictionOutputWithContext(ctx context.Context) FileHostingGeoRestrictionOutput {
+	return o
+}
+
+func (o FileHostingGeoRestrictionOutput) ToFileHostingGeoRestrictionPtrOutput() FileHostingGeoRestrictionPtrOutput {
+	return o.ToFileHostingGeoRestrictionPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingGeoRestrictionOutput) ToFileHostingGeoRestrictionPtrOutputWithContext(ctx context.Context) FileHostingGeoRestrictionPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingGeoRestriction) *FileHostingGeoRestriction {
+		return &v
+	}).(FileHostingGeoRestrictionPtrOutput)
+}
+
+// The ISO 3166-1 alpha-2 codes of the countries the restriction type applies to.
+func (o FileHostingGeoRestrictionOutput) Locations() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingGeoRestriction) []string { return v.Locations }).(pulumi.StringArrayOutput)
+}
+
+// How the locations are applied: whitelist only allows requests from them, blacklist blocks them and none disables the restriction.
+func (o FileHostingGeoRestrictionOutput) RestrictionType() pulumi.StringOutput {
+	return o.ApplyT(func(v FileHostingGeoRestriction) string { return v.RestrictionType }).(pulumi.StringOutput)
+}
+
+type FileHostingGeoRestrictionPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingGeoRestrictionPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingGeoRestriction)(nil)).Elem()
+}
+
+func (o FileHostingGeoRestrictionPtrOutput) ToFileHostingGeoRestrictionPtrOutput() FileHostingGeoRestrictionPtrOutput {
+	return o
+}
+
+func (o FileHostingGeoRestrictionPtrOutput) ToFileHostingGeoRestrictionPtrOutputWithContext(ctx context.Context) FileHostingGeoRestrictionPtrOutput {
+	return o
+}
+
+func (o FileHostingGeoRestrictionPtrOutput) Elem() FileHostingGeoRestrictionOutput {
+	return o.ApplyT(func(v *FileHostingGeoRestriction) FileHostingGeoRestriction {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingGeoRestriction
+		return ret
+	}).(FileHostingGeoRestrictionOutput)
+}
+
+// The ISO 3166-1 alpha-2 codes of the countries the restriction type applies to.
+func (o FileHostingGeoRestrictionPtrOutput) Locations() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingGeoRestriction) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Locations
+	}).(pulumi.StringArrayOutput)
+}
+
+// How the locations are applied: whitelist only allows requests from them, blacklist blocks them and none disables the restriction.
+func (o FileHostingGeoRestrictionPtrOutput) RestrictionType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingGeoRestriction) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.RestrictionType
+	}).(pulumi.StringPtrOutput)
+}
+
+// The key rotation settings of a FileHosting.
+type FileHostingKeyRotation struct {
+	// The generation of the key used for signing. Incrementing it creates a new key and rotates signing to it. Defaults to 1.
+	ActiveGeneration *int `pulumi:"activeGeneration"`
+	// The number of key generations kept in the trusted key group, including the active one. Previous keys stay trusted so that outstanding signatures remain valid. Defaults to 2.
+	Generations *int `pulumi:"generations"`
+}
+
+// FileHostingKeyRotationInput is an input type that accepts FileHostingKeyRotationArgs and FileHostingKeyRotationOutput values.
+// You can construct a concrete instance of `FileHostingKeyRotationInput` via:
+//
+//	FileHostingKeyRotationArgs{...}
+type FileHostingKeyRotationInput interface {
+	pulumi.Input
+
+	ToFileHostingKeyRotationOutput() FileHostingKeyRotationOutput
+	ToFileHostingKeyRotationOutputWithContext(context.Context) FileHostingKeyRotationOutput
+}
+
+// The key rotation settings of a FileHosting.
+type FileHostingKeyRotationArgs struct {
+	// The generation of the key used for signing. Incrementing it creates a new key and rotates signing to it. Defaults to 1.
+	ActiveGeneration *int `pulumi:"activeGeneration"`
+	// The number of key generations kept in the trusted key group, including the active one. Previous keys stay trusted so that outstanding signatures remain valid. Defaults to 2.
+	Generations *int `pulumi:"generations"`
+}
+
+func (FileHostingKeyRotationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingKeyRotation)(nil)).Elem()
+}
+
+func (i FileHostingKeyRotationArgs) ToFileHostingKeyRotationOutput() FileHostingKeyRotationOutput {
+	return i.ToFileHostingKeyRotationOutputWithContext(context.Background())
+}
+
+func (i FileHostingKeyRotationArgs) ToFileHostingKeyRotationOutputWithContext(ctx context.Context) FileHostingKeyRotationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingKeyRotationOutput)
+}
+
+func (i FileHostingKeyRotationArgs) ToFileHostingKeyRotationPtrOutput() FileHostingKeyRotationPtrOutput {
+	return i.ToFileHostingKeyRotationPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingKeyRotationArgs) ToFileHostingKeyRotationPtrOutputWithContext(ctx context.Context) FileHostingKeyRotationPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingKeyRotationOutput).ToFileHostingKeyRotationPtrOutputWithContext(ctx)
+}
+
+// FileHostingKeyRotationPtrInput is an input type that accepts FileHostingKeyRotationArgs, FileHostingKeyRotationPtr and FileHostingKeyRotationPtrOutput values.
+// You can construct a concrete instance of `FileHostingKeyRotationPtrInput` via:
+//
+//	        FileHostingKeyRotationArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingKeyRotationPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingKeyRotationPtrOutput() FileHostingKeyRotationPtrOutput
+	ToFileHostingKeyRotationPtrOutputWithContext(context.Context) FileHostingKeyRotationPtrOutput
+}
+
+type fileHostingKeyRotationPtrType FileHostingKeyRotationArgs
+
+func FileHostingKeyRotationPtr(v *FileHostingKeyRotationArgs) FileHostingKeyRotationPtrInput {
+	return (*fileHostingKeyRotationPtrType)(v)
+}
+
+func (*fileHostingKeyRotationPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingKeyRotation)(nil)).Elem()
+}
+
+func (i *fileHostingKeyRotationPtrType) ToFileHostingKeyRotationPtrOutput() FileHostingKeyRotationPtrOutput {
+	return i.ToFileHostingKeyRotationPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingKeyRotationPtrType) ToFileHostingKeyRotationPtrOutputWithContext(ctx context.Context) FileHostingKeyRotationPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingKeyRotationPtrOutput)
+}
+
+// The key rotation settings of a FileHosting.
+type FileHostingKeyRotationOutput struct{ *pulumi.OutputState }
+
+func (FileHostingKeyRotationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingKeyRotation)(nil)).Elem()
+}
+
+func (o FileHostingKeyRotationOutput) ToFileHostingKeyRotationOutput() FileHostingKeyRotationOutput {
+	return o
+}
+
+func (o FileHostingKeyRotationOutput) ToFileHostingKeyRotationOutputWithContext(ctx context.Context) FileHostingKeyRotationOutput {
+	return o
+}
+
+func (o FileHostingKeyRotationOutput) ToFileHostingKeyRotationPtrOutput() FileHostingKeyRotationPtrOutput {
+	return o.ToFileHostingKeyRotationPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingKeyRotationOutput) ToFileHostingKeyRotationPtrOutputWithContext(ctx context.Context) FileHostingKeyRotationPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingKeyRotation) *FileHostingKeyRotation {
+		return &v
+	}).(FileHostingKeyRotationPtrOutput)
+}
+
+// The generation of the key used for signing. Incrementing it creates a new key and rotates signing to it. Defaults to 1.
+func (o FileHostingKeyRotationOutput) ActiveGeneration() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingKeyRotation) *int { return v.ActiveGeneration }).(pulumi.IntPtrOutput)
+}
+
+// The number of key generations kept in the trusted key group, including the active one. Previous keys stay trusted so that outstanding signatures remain valid. Defaults to 2.
+func (o FileHostingKeyRotationOutput) Generations() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingKeyRotation) *int { return v.Generations }).(pulumi.IntPtrOutput)
+}
+
+type FileHostingKeyRotationPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingKeyRotationPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingKeyRotation)(nil)).Elem()
+}
+
+func (o FileHostingKeyRotationPtrOutput) ToFileHostingKeyRotationPtrOutput() FileHostingKeyRotationPtrOutput {
+	return o
+}
+
+func (o FileHostingKeyRotationPtrOutput) ToFileHostingKeyRotationPtrOutputWithContext(ctx context.Context) FileHostingKeyRotationPtrOutput {
+	return o
+}
+
+func (o FileHostingKeyRotationPtrOutput) Elem() FileHostingKeyRotationOutput {
+	return o.ApplyT(func(v *FileHostingKeyRotation) FileHostingKeyRotation {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingKeyRotation
+		return ret
+	}).(FileHostingKeyRotationOutput)
+}
+
+// The generation of the key used for signing. Incrementing it creates a new key and rotates signing to it. Defaults to 1.
+func (o FileHostingKeyRotationPtrOutput) ActiveGeneration() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *FileHostingKeyRotation) *int {
+		if v == nil {
+			return nil
+		}
+		return v.ActiveGeneration
+	}).(pulumi.IntPtrOutput)
+}
+
+// The number of key generations kept in the trusted key group, including the active one. Previous keys stay trusted so that outstanding signatures remain valid. Defaults to 2.
+func (o FileHostingKeyRotationPtrOutput) Generations() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *FileHostingKeyRotation) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Generations
+	}).(pulumi.IntPtrOutput)
+}
+
+// A lifecycle rule of the bucket of a FileHosting.
+type FileHostingLifecycleRule struct {
+	// The number of days after which objects expire.
+	ExpirationDays *int `pulumi:"expirationDays"`
+	// The number of days after which noncurrent object versions expire.
+	NoncurrentVersionExpirationDays *int `pulumi:"noncurrentVersionExpirationDays"`
+	// The key prefix the rule applies to. If not provided, the rule applies to all objects.
+	Prefix *string `pulumi:"prefix"`
+	// The transitions of objects to other storage classes.
+	Transitions []FileHostingLifecycleTransition `pulumi:"transitions"`
+}
+
+// FileHostingLifecycleRuleInput is an input type that accepts FileHostingLifecycleRuleArgs and FileHostingLifecycleRuleOutput values.
+// You can construct a concrete instance of `FileHostingLifecycleRuleInput` via:
+//
+//	FileHostingLifecycleRuleArgs{...}
+type FileHostingLifecycleRuleInput interface {
+	pulumi.Input
+
+	ToFileHostingLifecycleRuleOutput() FileHostingLifecycleRuleOutput
+	ToFileHostingLifecycleRuleOutputWithContext(context.Context) FileHostingLifecycleRuleOutput
+}
+
+// A lifecycle rule of the bucket of a FileHosting.
+type FileHostingLifecycleRuleArgs struct {
+	// The number of days after which objects expire.
+	ExpirationDays pulumi.IntPtrInput `pulumi:"expirationDays"`
+	// The number of days after which noncurrent object versions expire.
+	NoncurrentVersionExpirationDays pulumi.IntPtrInput `pulumi:"noncurrentVersionExpirationDays"`
+	// The key prefix the rule applies to. If not provided, the rule applies to all objects.
+	Prefix pulumi.StringPtrInput `pulumi:"prefix"`
+	// The transitions of objects to other storage classes.
+	Transitions []FileHostingLifecycleTransitionInput `pulumi:"transitions"`
+}
+
+func (FileHostingLifecycleRuleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingLifecycleRule)(nil)).Elem()
+}
+
+func (i FileHostingLifecycleRuleArgs) ToFileHostingLifecycleRuleOutput() FileHostingLifecycleRuleOutput {
+	return i.ToFileHostingLifecycleRuleOutputWithContext(context.Background())
+}
+
+func (i FileHostingLifecycleRuleArgs) ToFileHostingLifecycleRuleOutputWithContext(ctx context.Context) FileHostingLifecycleRuleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingLifecycleRuleOutput)
+}
+
+// FileHostingLifecycleRuleArrayInput is an input type that accepts FileHostingLifecycleRuleArray and FileHostingLifecycleRuleArrayOutput values.
+// You can construct a concrete instance of `FileHostingLifecycleRuleArrayInput` via:
+//
+//	FileHostingLifecycleRuleArray{ FileHostingLifecycleRuleArgs{...} }
+type FileHostingLifecycleRuleArrayInput interface {
+	pulumi.Input
+
+	ToFileHostingLifecycleRuleArrayOutput() FileHostingLifecycleRuleArrayOutput
+	ToFileHostingLifecycleRuleArrayOutputWithContext(context.Context) FileHostingLifecycleRuleArrayOutput
+}
+
+type FileHostingLifecycleRuleArray []FileHostingLifecycleRuleInput
+
+func (FileHostingLifecycleRuleArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]FileHostingLifecycleRule)(nil)).Elem()
+}
+
+func (i FileHostingLifecycleRuleArray) ToFileHostingLifecycleRuleArrayOutput() FileHostingLifecycleRuleArrayOutput {
+	return i.ToFileHostingLifecycleRuleArrayOutputWithContext(context.Background())
+}
+
+func (i FileHostingLifecycleRuleArray) ToFileHostingLifecycleRuleArrayOutputWithContext(ctx context.Context) FileHostingLifecycleRuleArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingLifecycleRuleArrayOutput)
+}
+
+// A lifecycle rule of the bucket of a FileHosting.
+type FileHostingLifecycleRuleOutput struct{ *pulumi.OutputState }
+
+func (FileHostingLifecycleRuleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingLifecycleRule)(nil)).Elem()
+}
+
+func (o FileHostingLifecycleRuleOutput) ToFileHostingLifecycleRuleOutput() FileHostingLifecycleRuleOutput {
+	return o
+}
+
+func (o FileHostingLifecycleRuleOutput) ToFileHostingLifecycleRuleOutputWithContext(ctx context.Context) FileHostingLifecycleRuleOutput {
+	return o
+}
+
+// The number of days after which objects expire.
+func (o FileHostingLifecycleRuleOutput) ExpirationDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingLifecycleRule) *int { return v.ExpirationDays }).(pulumi.IntPtrOutput)
+}
+
+// The number of days after which noncurrent object versions expire.
+func (o FileHostingLifecycleRuleOutput) NoncurrentVersionExpirationDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingLifecycleRule) *int { return v.NoncurrentVersionExpirationDays }).(pulumi.IntPtrOutput)
+}
+
+// The key prefix the rule applies to. If not provided, the rule applies to all objects.
+func (o FileHostingLifecycleRuleOutput) Prefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingLifecycleRule) *string { return v.Prefix }).(pulumi.StringPtrOutput)
+}
+
+// The transitions of objects to other storage classes.
+func (o FileHostingLifecycleRuleOutput) Transitions() FileHostingLifecycleTransitionArrayOutput {
+	return o.ApplyT(func(v FileHostingLifecycleRule) []FileHostingLifecycleTransition { return v.Transitions }).(FileHostingLifecycleTransitionArrayOutput)
+}
+
+type FileHostingLifecycleRuleArrayOutput struct{ *pulumi.OutputState }
+
+func (FileHostingLifecycleRuleArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]FileHostingLifecycleRule)(nil)).Elem()
+}
+
+func (o FileHostingLifecycleRuleArrayOutput) ToFileHostingLifecycleRuleArrayOutput() FileHostingLifecycleRuleArrayOutput {
+	return o
+}
+
+func (o FileHostingLifecycleRuleArrayOutput) ToFileHostingLifecycleRuleArrayOutputWithContext(ctx context.Context) FileHostingLifecycleRuleArrayOutput {
+	return o
+}
+
+func (o FileHostingLifecycleRuleArrayOutput) Index(i pulumi.IntInput) FileHostingLifecycleRuleOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) FileHostingLifecycleRule {
+		return vs[0].([]FileHostingLifecycleRule)[vs[1].(int)]
+	}).(FileHostingLifecycleRuleOutput)
+}
+
+// A transition of objects to another storage class.
+type FileHostingLifecycleTransition struct {
+	// The number of days after which objects are transitioned.
+	Days int `pulumi:"days"`
+	// The storage class objects are transitioned to, e.g. STANDARD_IA or GLACIER_IR.
+	StorageClass string `pulumi:"storageClass"`
+}
+
+// FileHostingLifecycleTransitionInput is an input type that accepts FileHostingLifecycleTransitionArgs and FileHostingLifecycleTransitionOutput values.
+// You can construct a concrete instance of `FileHostingLifecycleTransitionInput` via:
+//
+//	FileHostingLifecycleTransitionArgs{...}
+type FileHostingLifecycleTransitionInput interface {
+	pulumi.Input
+
+	ToFileHostingLifecycleTransitionOutput() FileHostingLifecycleTransitionOutput
+	ToFileHostingLifecycleTransitionOutputWithContext(context.Context) FileHostingLifecycleTransitionOutput
+}
+
+// A transition of objects to another storage class.
+type FileHostingLifecycleTransitionArgs struct {
+	// The number of days after which objects are transitioned.
+	Days pulumi.IntInput `pulumi:"days"`
+	// The storage class objects are transitioned to, e.g. STANDARD_IA or GLACIER_IR.
+	StorageClass pulumi.StringInput `pulumi:"storageClass"`
+}
+
+func (FileHostingLifecycleTransitionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingLifecycleTransition)(nil)).Elem()
+}
+
+func (i FileHostingLifecycleTransitionArgs) ToFileHostingLifecycleTransitionOutput() FileHostingLifecycleTransitionOutput {
+	return i.ToFileHostingLifecycleTransitionOutputWithContext(context.Background())
+}
+
+func (i FileHostingLifecycleTransitionArgs) ToFileHostingLifecycleTransitionOutputWithContext(ctx context.Context) FileHostingLifecycleTransitionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingLifecycleTransitionOutput)
+}
+
+// FileHostingLifecycleTransitionArrayInput is an input type that accepts FileHostingLifecycleTransitionArray and FileHostingLifecycleTransitionArrayOutput values.
+// You can construct a concrete instance of `FileHostingLifecycleTransitionArrayInput` via:
+//
+//	FileHostingLifecycleTransitionArray{ FileHostingLifecycleTransitionArgs{...} }
+type FileHostingLifecycleTransitionArrayInput interface {
+	pulumi.Input
+
+	ToFileHostingLifecycleTransitionArrayOutput() FileHostingLifecycleTransitionArrayOutput
+	ToFileHostingLifecycleTransitionArrayOutputWithContext(context.Context) FileHostingLifecycleTransitionArrayOutput
+}
+
+type FileHostingLifecycleTransitionArray []FileHostingLifecycleTransitionInput
+
+func (FileHostingLifecycleTransitionArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]FileHostingLifecycleTransition)(nil)).Elem()
+}
+
+func (i FileHostingLifecycleTransitionArray) ToFileHostingLifecycleTransitionArrayOutput() FileHostingLifecycleTransitionArrayOutput {
+	return i.ToFileHostingLifecycleTransitionArrayOutputWithContext(context.Background())
+}
+
+func (i FileHostingLifecycleTransitionArray) ToFileHostingLifecycleTransitionArrayOutputWithContext(ctx context.Context) FileHostingLifecycleTransitionArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingLifecycleTransitionArrayOutput)
+}
+
+// A transition of objects to another storage class.
+type FileHostingLifecycleTransitionOutput struct{ *pulumi.OutputState }
+
+func (FileHostingLifecycleTransitionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingLifecycleTransition)(nil)).Elem()
+}
+
+func (o FileHostingLifecycleTransitionOutput) ToFileHostingLifecycleTransitionOutput() FileHostingLifecycleTransitionOutput {
+	return o
+}
+
+func (o FileHostingLifecycleTransitionOutput) ToFileHostingLifecycleTransitionOutputWithContext(ctx context.Context) FileHostingLifecycleTransitionOutput {
+	return o
+}
+
+// The number of days after which objects are transitioned.
+func (o FileHostingLifecycleTransitionOutput) Days() pulumi.IntOutput {
+	return o.ApplyT(func(v FileHostingLifecycleTransition) int { return v.Days }).(pulumi.IntOutput)
+}
+
+// The storage class objects are transitioned to, e.g. STANDARD_IA or GLACIER_IR.
+func (o FileHostingLifecycleTransitionOutput) StorageClass() pulumi.StringOutput {
+	return o.ApplyT(func(v FileHostingLifecycleTransition) string { return v.StorageClass }).(pulumi.StringOutput)
+}
+
+type FileHostingLifecycleTransitionArrayOutput struct{ *pulumi.OutputState }
+
+func (FileHostingLifecycleTransitionArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]FileHostingLifecycleTransition)(nil)).Elem()
+}
+
+func (o FileHostingLifecycleTransitionArrayOutput) ToFileHostingLifecycleTransitionArrayOutput() FileHostingLifecycleTransitionArrayOutput {
+	return o
+}
+
+func (o FileHostingLifecycleTransitionArrayOutput) ToFileHostingLifecycleTransitionArrayOutputWithContext(ctx context.Context) FileHostingLifecycleTransitionArrayOutput {
+	return o
+}
+
+func (o FileHostingLifecycleTransitionArrayOutput) Index(i pulumi.IntInput) FileHostingLifecycleTransitionOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) FileHostingLifecycleTransition {
+		return vs[0].([]FileHostingLifecycleTransition)[vs[1].(int)]
+	}).(FileHostingLifecycleTransitionOutput)
+}
+
+// The access logging settings of a FileHosting.
+type FileHostingLogging struct {
+	// The name of an existing bucket to write the logs to. It needs ACLs enabled for CloudFront standard logs and a bucket policy allowing S3 server access logs. If not provided, a log bucket is created.
+	BucketName *string `pulumi:"bucketName"`
+	// The key prefix of the CloudFront standard logs. Defaults to cloudfront/.
+	CloudFrontPrefix *string `pulumi:"cloudFrontPrefix"`
+	// Whether CloudFront logs include cookies. Defaults to false.
+	IncludeCookies *bool `pulumi:"includeCookies"`
+	// The number of days logs are kept in a created log bucket. Defaults to 90.
+	RetentionDays *int `pulumi:"retentionDays"`
+	// The key prefix of the S3 server access logs. Defaults to s3/.
+	S3Prefix *string `pulumi:"s3Prefix"`
+}
+
+// FileHostingLoggingInput is an input type that accepts FileHostingLoggingArgs and FileHostingLoggingOutput values.
+// You can construct a concrete instance of `FileHostingLoggingInput` via:
+//
+//	FileHostingLoggingArgs{...}
+type FileHostingLoggingInput interface {
+	pulumi.Input
+
+	ToFileHostingLoggingOutput() FileHostingLoggingOutput
+	ToFileHostingLoggingOutputWithContext(context.Context) FileHostingLoggingOutput
+}
+
+// The access logging settings of a FileHosting.
+type FileHostingLoggingArgs struct {
+	// The name of an existing bucket to write the logs to. It needs ACLs enabled for CloudFront standard logs and a bucket policy allowing S3 server access logs. If not provided, a log bucket is created.
+	BucketName pulumi.StringPtrInput `pulumi:"bucketName"`
+	// The key prefix of the CloudFront standard logs. Defaults to cloudfront/.
+	CloudFrontPrefix pulumi.StringPtrInput `pulumi:"cloudFrontPrefix"`
+	// Whether CloudFront logs include cookies. Defaults to false.
+	IncludeCookies pulumi.BoolPtrInput `pulumi:"includeCookies"`
+	// The number of days logs are kept in a created log bucket. Defaults to 90.
+	RetentionDays pulumi.IntPtrInput `pulumi:"retentionDays"`
+	// The key prefix of the S3 server access logs. Defaults to s3/.
+	S3Prefix pulumi.StringPtrInput `pulumi:"s3Prefix"`
+}
+
+func (FileHostingLoggingArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingLogging)(nil)).Elem()
+}
+
+func (i FileHostingLoggingArgs) ToFileHostingLoggingOutput() FileHostingLoggingOutput {
+	return i.ToFileHostingLoggingOutputWithContext(context.Background())
+}
+
+func (i FileHostingLoggingArgs) ToFileHostingLoggingOutputWithContext(ctx context.Context) FileHostingLoggingOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingLoggingOutput)
+}
+
+func (i FileHostingLoggingArgs) ToFileHostingLoggingPtrOutput() FileHostingLoggingPtrOutput {
+	return i.ToFileHostingLoggingPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingLoggingArgs) ToFileHostingLoggingPtrOutputWithContext(ctx context.Context) FileHostingLoggingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingLoggingOutput).ToFileHostingLoggingPtrOutputWithContext(ctx)
+}
+
+// FileHostingLoggingPtrInput is an input type that accepts FileHostingLoggingArgs, FileHostingLoggingPtr and FileHostingLoggingPtrOutput values.
+// You can construct a concrete instance of `FileHostingLoggingPtrInput` via:
+//
+//	        FileHostingLoggingArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingLoggingPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingLoggingPtrOutput() FileHostingLoggingPtrOutput
+	ToFileHostingLoggingPtrOutputWithContext(context.Context) FileHostingLoggingPtrOutput
+}
+
+type fileHostingLoggingPtrType FileHostingLoggingArgs
+
+func FileHostingLoggingPtr(v *FileHostingLoggingArgs) FileHostingLoggingPtrInput {
+	return (*fileHostingLoggingPtrType)(v)
+}
+
+func (*fileHostingLoggingPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingLogging)(nil)).Elem()
+}
+
+func (i *fileHostingLoggingPtrType) ToFileHostingLoggingPtrOutput() FileHostingLoggingPtrOutput {
+	return i.ToFileHostingLoggingPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingLoggingPtrType) ToFileHostingLoggingPtrOutputWithContext(ctx context.Context) FileHostingLoggingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingLoggingPtrOutput)
+}
+
+// The access logging settings of a FileHosting.
+type FileHostingLoggingOutput struct{ *pulumi.OutputState }
+
+func (FileHostingLoggingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingLogging)(nil)).Elem()
+}
+
+func (o FileHostingLoggingOutput) ToFileHostingLoggingOutput() FileHostingLoggingOutput {
+	return o
+}
+
+func (o FileHostingLoggingOutput) ToFileHostingLoggingOutputWithContext(ctx context.Context) FileHostingLoggingOutput {
+	return o
+}
+
+func (o FileHostingLoggingOutput) ToFileHostingLoggingPtrOutput() FileHostingLoggingPtrOutput {
+	return o.ToFileHostingLoggingPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingLoggingOutput) ToFileHostingLoggingPtrOutputWithContext(ctx context.Context) FileHostingLoggingPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingLogging) *FileHostingLogging {
+		return &v
+	}).(FileHostingLoggingPtrOutput)
+}
+
+// The name of an existing bucket to write the logs to. It needs ACLs enabled for CloudFront standard logs and a bucket policy allowing S3 server access logs. If not provided, a log bucket is created.
+func (o FileHostingLoggingOutput) BucketName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingLogging) *string { return v.BucketName }).(pulumi.StringPtrOutput)
+}
+
+// The key prefix of the CloudFront standard logs. Defaults to cloudfront/.
+func (o FileHostingLoggingOutput) CloudFrontPrefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingLogging) *string { return v.CloudFrontPrefix }).(pulumi.StringPtrOutput)
+}
+
+// Whether CloudFront logs include cookies. Defaults to false.
+func (o FileHostingLoggingOutput) IncludeCookies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingLogging) *bool { return v.IncludeCookies }).(pulumi.BoolPtrOutput)
+}
+
+// The number of days logs are kept in a created log bucket. Defaults to 90.
+func (o FileHostingLoggingOutput) RetentionDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingLogging) *int { return v.RetentionDays }).(pulumi.IntPtrOutput)
+}
+
+// The key prefix of the S3 server access logs. Defaults to s3/.
+func (o FileHostingLoggingOutput) S3Prefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingLogging) *string { return v.S3Prefix }).(pulumi.StringPtrOutput)
+}
+
+type FileHostingLoggingPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingLoggingPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingLogging)(nil)).Elem()
+}
+
+func (o FileHostingLoggingPtrOutput) ToFileHostingLoggingPtrOutput() FileHostingLoggingPtrOutput {
+	return o
+}
+
+func (o FileHostingLoggingPtrOutput) ToFileHostingLoggingPtrOutputWithContext(ctx context.Context) FileHostingLoggingPtrOutput {
+	return o
+}
+
+func (o FileHostingLoggingPtrOutput) Elem() FileHostingLoggingOutput {
+	return o.ApplyT(func(v *FileHostingLogging) FileHostingLogging {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingLogging
+		return ret
+	}).(FileHostingLoggingOutput)
+}
+
+// The name of an existing bucket to write the logs to. It needs ACLs enabled for CloudFront standard logs and a bucket policy allowing S3 server access logs. If not provided, a log bucket is created.
+func (o FileHostingLoggingPtrOutput) BucketName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingLogging) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BucketName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The key prefix of the CloudFront standard logs. Defaults to cloudfront/.
+func (o FileHostingLoggingPtrOutput) CloudFrontPrefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingLogging) *string {
+		if v == nil {
+			return nil
+		}
+		return v.CloudFrontPrefix
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether CloudFront logs include cookies. Defaults to false.
+func (o FileHostingLoggingPtrOutput) IncludeCookies() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingLogging) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.IncludeCookies
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The number of days logs are kept in a created log bucket. Defaults to 90.
+func (o FileHostingLoggingPtrOutput) RetentionDays() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *FileHostingLogging) *int {
+		if v == nil {
+			return nil
+		}
+		return v.RetentionDays
+	}).(pulumi.IntPtrOutput)
+}
+
+// The key prefix of the S3 server access logs. Defaults to s3/.
+func (o FileHostingLoggingPtrOutput) S3Prefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingLogging) *string {
+		if v == nil {
+			return nil
+		}
+		return v.S3Prefix
+	}).(pulumi.StringPtrOutput)
+}
+
+// The event notifications of the bucket of a FileHosting. The notification configuration replaces any existing configuration of the bucket. The events go to a single destination and optionally to EventBridge.
+type FileHostingNotifications struct {
+	// Whether all events of the bucket are sent to EventBridge. Defaults to false.
+	EventBridge *bool `pulumi:"eventBridge"`
+	// The S3 events notifications are sent for. Defaults to s3:ObjectCreated:*.
+	Events []string `pulumi:"events"`
+	// The key prefix of the objects notifications are sent for, e.g. uploads/.
+	FilterPrefix *string `pulumi:"filterPrefix"`
+	// The key suffix of the objects notifications are sent for, e.g. .jpg.
+	FilterSuffix *string `pulumi:"filterSuffix"`
+	// The ARN of a Lambda function in the region of the bucket to invoke. The permission for S3 to invoke it is created. Conflicts with the other destinations.
+	LambdaFunctionArn *string `pulumi:"lambdaFunctionArn"`
+	// Whether to create an SQS queue the notifications are sent to. Conflicts with the other destinations.
+	Queue *bool `pulumi:"queue"`
+	// The ARN of an existing SQS queue to send the notifications to. Its policy has to allow S3 to send messages. Conflicts with the other destinations.
+	QueueArn *string `pulumi:"queueArn"`
+	// Whether to create an SNS topic the notifications are published to. Conflicts with the other destinations.
+	Topic *bool `pulumi:"topic"`
+	// The ARN of an existing SNS topic to publish the notifications to. Its policy has to allow S3 to publish. Conflicts with the other destinations.
+	TopicArn *string `pulumi:"topicArn"`
+}
+
+// FileHostingNotificationsInput is an input type that accepts FileHostingNotificationsArgs and FileHostingNotificationsOutput values.
+// You can construct a concrete instance of `FileHostingNotificationsInput` via:
+//
+//	FileHostingNotificationsArgs{...}
+type FileHostingNotificationsInput interface {
+	pulumi.Input
+
+	ToFileHostingNotificationsOutput() FileHostingNotificationsOutput
+	ToFileHostingNotificationsOutputWithContext(context.Context) FileHostingNotificationsOutput
+}
+
+// The event notifications of the bucket of a FileHosting. The notification configuration replaces any existing configuration of the bucket. The events go to a single destination and optionally to EventBridge.
+type FileHostingNotificationsArgs struct {
+	// Whether all events of the bucket are sent to EventBridge. Defaults to false.
+	EventBridge *bool `pulumi:"eventBridge"`
+	// The S3 events notifications are sent for. Defaults to s3:ObjectCreated:*.
+	Events []pulumi.StringInput `pulumi:"events"`
+	// The key prefix of the objects notifications are sent for, e.g. uploads/.
+	FilterPrefix pulumi.StringPtrInput `pulumi:"filterPrefix"`
+	// The key suffix of the objects notifications are sent for, e.g. .jpg.
+	FilterSuffix pulumi.StringPtrInput `pulumi:"filterSuffix"`
+	// The ARN of a Lambda function in the region of the bucket to invoke. The permission for S3 to invoke it is created. Conflicts with the other destinations.
+	LambdaFunctionArn pulumi.StringPtrInput `pulumi:"lambdaFunctionArn"`
+	// Whether to create an SQS queue the notifications are sent to. Conflicts with the other destinations.
+	Queue *bool `pulumi:"queue"`
+	// The ARN of an existing SQS queue to send the notifications to. Its policy has to allow S3 to send messages. Conflicts with the other destinations.
+	QueueArn pulumi.StringPtrInput `pulumi:"queueArn"`
+	// Whether to create an SNS topic the notifications are published to. Conflicts with the other destinations.
+	Topic *bool `pulumi:"topic"`
+	// The ARN of an existing SNS topic to publish the notifications to. Its policy has to allow S3 to publish. Conflicts with the other destinations.
+	TopicArn pulumi.StringPtrInput `pulumi:"topicArn"`
+}
+
+func (FileHostingNotificationsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingNotifications)(nil)).Elem()
+}
+
+func (i FileHostingNotificationsArgs) ToFileHostingNotificationsOutput() FileHostingNotificationsOutput {
+	return i.ToFileHostingNotificationsOutputWithContext(context.Background())
+}
+
+func (i FileHostingNotificationsArgs) ToFileHostingNotificationsOutputWithContext(ctx context.Context) FileHostingNotificationsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingNotificationsOutput)
+}
+
+func (i FileHostingNotificationsArgs) ToFileHostingNotificationsPtrOutput() FileHostingNotificationsPtrOutput {
+	return i.ToFileHostingNotificationsPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingNotificationsArgs) ToFileHostingNotificationsPtrOutputWithContext(ctx context.Context) FileHostingNotificationsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingNotificationsOutput).ToFileHostingNotificationsPtrOutputWithContext(ctx)
+}
+
+// FileHostingNotificationsPtrInput is an input type that accepts FileHostingNotificationsArgs, FileHostingNotificationsPtr and FileHostingNotificationsPtrOutput values.
+// You can construct a concrete instance of `FileHostingNotificationsPtrInput` via:
+//
+//	        FileHostingNotificationsArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingNotificationsPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingNotificationsPtrOutput() FileHostingNotificationsPtrOutput
+	ToFileHostingNotificationsPtrOutputWithContext(context.Context) FileHostingNotificationsPtrOutput
+}
+
+type fileHostingNotificationsPtrType FileHostingNotificationsArgs
+
+func FileHostingNotificationsPtr(v *FileHostingNotificationsArgs) FileHostingNotificationsPtrInput {
+	return (*fileHostingNotificationsPtrType)(v)
+}
+
+func (*fileHostingNotificationsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingNotifications)(nil)).Elem()
+}
+
+func (i *fileHostingNotificationsPtrType) ToFileHostingNotificationsPtrOutput() FileHostingNotificationsPtrOutput {
+	return i.ToFileHostingNotificationsPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingNotificationsPtrType) ToFileHostingNotificationsPtrOutputWithContext(ctx context.Context) FileHostingNotificationsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingNotificationsPtrOutput)
+}
+
+// The event notifications of the bucket of a FileHosting. The notification configuration replaces any existing configuration of the bucket. The events go to a single destination and optionally to EventBridge.
+type FileHostingNotificationsOutput struct{ *pulumi.OutputState }
+
+func (FileHostingNotificationsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingNotifications)(nil)).Elem()
+}
+
+func (o FileHostingNotificationsOutput) ToFileHostingNotificationsOutput() FileHostingNotificationsOutput {
+	return o
+}
+
+func (o FileHostingNotificationsOutput) ToFileHostingNotificationsOutputWithContext(ctx context.Context) FileHostingNotificationsOutput {
+	return o
+}
+
+func (o FileHostingNotificationsOutput) ToFileHostingNotificationsPtrOutput() FileHostingNotificationsPtrOutput {
+	return o.ToFileHostingNotificationsPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingNotificationsOutput) ToFileHostingNotificationsPtrOutputWithContext(ctx context.Context) FileHostingNotificationsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingNotifications) *FileHostingNotifications {
+		return &v
+	}).(FileHostingNotificationsPtrOutput)
+}
+
+// Whether all events of the bucket are sent to EventBridge. Defaults to false.
+func (o FileHostingNotificationsOutput) EventBridge() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingNotifications) *bool { return v.EventBridge }).(pulumi.BoolPtrOutput)
+}
+
+// The S3 events notifications are sent for. Defaults to s3:ObjectCreated:*.
+func (o FileHostingNotificationsOutput) Events() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingNotifications) []string { return v.Events }).(pulumi.StringArrayOutput)
+}
+
+// The key prefix of the objects notifications are sent for, e.g. uploads/.
+func (o FileHostingNotificationsOutput) FilterPrefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingNotifications) *string { return v.FilterPrefix }).(pulumi.StringPtrOutput)
+}
+
+// The key suffix of the objects notifications are sent for, e.g. .jpg.
+func (o FileHostingNotificationsOutput) FilterSuffix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingNotifications) *string { return v.FilterSuffix }).(pulumi.StringPtrOutput)
+}
+
+// The ARN of a Lambda function in the region of the bucket to invoke. The permission for S3 to invoke it is created. Conflicts with the other destinations.
+func (o FileHostingNotificationsOutput) LambdaFunctionArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingNotifications) *string { return v.LambdaFunctionArn }).(pulumi.StringPtrOutput)
+}
+
+// Whether to create an SQS queue the notifications are sent to. Conflicts with the other destinations.
+func (o FileHostingNotificationsOutput) Queue() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingNotifications) *bool { return v.Queue }).(pulumi.BoolPtrOutput)
+}
+
+// The ARN of an existing SQS queue to send the notifications to. Its policy has to allow S3 to send messages. Conflicts with the other destinations.
+func (o FileHostingNotificationsOutput) QueueArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingNotifications) *string { return v.QueueArn }).(pulumi.StringPtrOutput)
+}
+
+// Whether to create an SNS topic the notifications are published to. Conflicts with the other destinations.
+func (o FileHostingNotificationsOutput) Topic() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingNotifications) *bool { return v.Topic }).(pulumi.BoolPtrOutput)
+}
+
+// The ARN of an existing SNS topic to publish the notifications to. Its policy has to allow S3 to publish. Conflicts with the other destinations.
+func (o FileHostingNotificationsOutput) TopicArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingNotifications) *string { return v.TopicArn }).(pulumi.StringPtrOutput)
+}
+
+type FileHostingNotificationsPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingNotificationsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingNotifications)(nil)).Elem()
+}
+
+func (o FileHostingNotificationsPtrOutput) ToFileHostingNotificationsPtrOutput() FileHostingNotificationsPtrOutput {
+	return o
+}
+
+func (o FileHostingNotificationsPtrOutput) ToFileHostingNotificationsPtrOutputWithContext(ctx context.Context) FileHostingNotificationsPtrOutput {
+	return o
+}
+
+func (o FileHostingNotificationsPtrOutput) Elem() FileHostingNotificationsOutput {
+	return o.ApplyT(func(v *FileHostingNotifications) FileHostingNotifications {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingNotifications
+		return ret
+	}).(FileHostingNotificationsOutput)
+}
+
+// Whether all events of the bucket are sent to EventBridge. Defaults to false.
+func (o FileHostingNotificationsPtrOutput) EventBridge() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingNotifications) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EventBridge
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The S3 events notifications are sent for. Defaults to s3:ObjectCreated:*.
+func (o FileHostingNotificationsPtrOutput) Events() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingNotifications) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Events
+	}).(pulumi.StringArrayOutput)
+}
+
+// The key prefix of the objects notifications are sent for, e.g. uploads/.
+func (o FileHostingNotificationsPtrOutput) FilterPrefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingNotifications) *string {
+		if v == nil {
+			return nil
+		}
+		return v.FilterPrefix
+	}).(pulumi.StringPtrOutput)
+}
+
+// The key suffix of the objects notifications are sent for, e.g. .jpg.
+func (o FileHostingNotificationsPtrOutput) FilterSuffix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingNotifications) *string {
+		if v == nil {
+			return nil
+		}
+		return v.FilterSuffix
+	}).(pulumi.StringPtrOutput)
+}
+
+// The ARN of a Lambda function in the region of the bucket to invoke. The permission for S3 to invoke it is created. Conflicts with the other destinations.
+func (o FileHostingNotificationsPtrOutput) LambdaFunctionArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingNotifications) *string {
+		if v == nil {
+			return nil
+		}
+		return v.LambdaFunctionArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether to create an SQS queue the notifications are sent to. Conflicts with the other destinations.
+func (o FileHostingNotificationsPtrOutput) Queue() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingNotifications) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Queue
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The ARN of an existing SQS queue to send the notifications to. Its policy has to allow S3 to send messages. Conflicts with the other destinations.
+func (o FileHostingNotificationsPtrOutput) QueueArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingNotifications) *string {
+		if v == nil {
+			return nil
+		}
+		return v.QueueArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether to create an SNS topic the notifications are published to. Conflicts with the other destinations.
+func (o FileHostingNotificationsPtrOutput) Topic() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingNotifications) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Topic
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The ARN of an existing SNS topic to publish the notifications to. Its policy has to allow S3 to publish. Conflicts with the other destinations.
+func (o FileHostingNotificationsPtrOutput) TopicArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingNotifications) *string {
+		if v == nil {
+			return nil
+		}
+		return v.TopicArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// The settings of the origin request policy a FileHosting creates.
+type FileHostingOriginRequestPolicy struct {
+	// Which cookies are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to none.
+	CookieBehavior *string `pulumi:"cookieBehavior"`
+	// The cookies the cookie behavior applies to.
+	Cookies []string `pulumi:"cookies"`
+	// Which headers are forwarded to the bucket: none, whitelist, allViewer, allViewerAndWhitelistCloudFront or allExcept. Defaults to whitelist.
+	HeaderBehavior *string `pulumi:"headerBehavior"`
+	// The headers the header behavior applies to. Defaults to Content-Type.
+	Headers []string `pulumi:"headers"`
+	// Which query strings are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to whitelist.
+	QueryStringBehavior *string `pulumi:"queryStringBehavior"`
+	// The query strings the query string behavior applies to. Defaults to partNumber and uploadId, which multipart uploads need.
+	QueryStrings []string `pulumi:"queryStrings"`
+}
+
+// FileHostingOriginRequestPolicyInput is an input type that accepts FileHostingOriginRequestPolicyArgs and FileHostingOriginRequestPolicyOutput values.
+// You can construct a concrete instance of `FileHostingOriginRequestPolicyInput` via:
+//
+//	FileHostingOriginRequestPolicyArgs{...}
+type FileHostingOriginRequestPolicyInput interface {
+	pulumi.Input
+
+	ToFileHostingOriginRequestPolicyOutput() FileHostingOriginRequestPolicyOutput
+	ToFileHostingOriginRequestPolicyOutputWithContext(context.Context) FileHostingOriginRequestPolicyOutput
+}
+
+// The settings of the origin request policy a FileHosting creates.
+type FileHostingOriginRequestPolicyArgs struct {
+	// Which cookies are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to none.
+	CookieBehavior pulumi.StringPtrInput `pulumi:"cookieBehavior"`
+	// The cookies the cookie behavior applies to.
+	Cookies pulumi.StringArrayInput `pulumi:"cookies"`
+	// Which headers are forwarded to the bucket: none, whitelist, allViewer, allViewerAndWhitelistCloudFront or allExcept. Defaults to whitelist.
+	HeaderBehavior pulumi.StringPtrInput `pulumi:"headerBehavior"`
+	// The headers the header behavior applies to. Defaults to Content-Type.
+	Headers pulumi.StringArrayInput `pulumi:"headers"`
+	// Which query strings are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to whitelist.
+	QueryStringBehavior pulumi.StringPtrInput `pulumi:"queryStringBehavior"`
+	// The query strings the query string behavior applies to. Defaults to partNumber and uploadId, which multipart uploads need.
+	QueryStrings pulumi.StringArrayInput `pulumi:"queryStrings"`
+}
+
+func (FileHostingOriginRequestPolicyArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingOriginRequestPolicy)(nil)).Elem()
+}
+
+func (i FileHostingOriginRequestPolicyArgs) ToFileHostingOriginRequestPolicyOutput() FileHostingOriginRequestPolicyOutput {
+	return i.ToFileHostingOriginRequestPolicyOutputWithContext(context.Background())
+}
+
+func (i FileHostingOriginRequestPolicyArgs) ToFileHostingOriginRequestPolicyOutputWithContext(ctx context.Context) FileHostingOriginRequestPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingOriginRequestPolicyOutput)
+}
+
+func (i FileHostingOriginRequestPolicyArgs) ToFileHostingOriginRequestPolicyPtrOutput() FileHostingOriginRequestPolicyPtrOutput {
+	return i.ToFileHostingOriginRequestPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingOriginRequestPolicyArgs) ToFileHostingOriginRequestPolicyPtrOutputWithContext(ctx context.Context) FileHostingOriginRequestPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingOriginRequestPolicyOutput).ToFileHostingOriginRequestPolicyPtrOutputWithContext(ctx)
+}
+
+// FileHostingOriginRequestPolicyPtrInput is an input type that accepts FileHostingOriginRequestPolicyArgs, FileHostingOriginRequestPolicyPtr and FileHostingOriginRequestPolicyPtrOutput values.
+// You can construct a concrete instance of `FileHostingOriginRequestPolicyPtrInput` via:
+//
+//	        FileHostingOriginRequestPolicyArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingOriginRequestPolicyPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingOriginRequestPolicyPtrOutput() FileHostingOriginRequestPolicyPtrOutput
+	ToFileHostingOriginRequestPolicyPtrOutputWithContext(context.Context) FileHostingOriginRequestPolicyPtrOutput
+}
+
+type fileHostingOriginRequestPolicyPtrType FileHostingOriginRequestPolicyArgs
+
+func FileHostingOriginRequestPolicyPtr(v *FileHostingOriginRequestPolicyArgs) FileHostingOriginRequestPolicyPtrInput {
+	return (*fileHostingOriginRequestPolicyPtrType)(v)
+}
+
+func (*fileHostingOriginRequestPolicyPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingOriginRequestPolicy)(nil)).Elem()
+}
+
+func (i *fileHostingOriginRequestPolicyPtrType) ToFileHostingOriginRequestPolicyPtrOutput() FileHostingOriginRequestPolicyPtrOutput {
+	return i.ToFileHostingOriginRequestPolicyPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingOriginRequestPolicyPtrType) ToFileHostingOriginRequestPolicyPtrOutputWithContext(ctx context.Context) FileHostingOriginRequestPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingOriginRequestPolicyPtrOutput)
+}
+
+// The settings of the origin request policy a FileHosting creates.
+type FileHostingOriginRequestPolicyOutput struct{ *pulumi.OutputState }
+
+func (FileHostingOriginRequestPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingOriginRequestPolicy)(nil)).Elem()
+}
+
+func (o FileHostingOriginRequestPolicyOutput) ToFileHostingOriginRequestPolicyOutput() FileHostingOriginRequestPolicyOutput {
+	return o
+}
+
+func (o FileHostingOriginRequestPolicyOutput) ToFileHostingOriginRequestPolicyOutputWithContext(ctx context.Context) FileHostingOriginRequestPolicyOutput {
+	return o
+}
+
+func (o FileHostingOriginRequestPolicyOutput) ToFileHostingOriginRequestPolicyPtrOutput() FileHostingOriginRequestPolicyPtrOutput {
+	return o.ToFileHostingOriginRequestPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingOriginRequestPolicyOutput) ToFileHostingOriginRequestPolicyPtrOutputWithContext(ctx context.Context) FileHostingOriginRequestPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingOriginRequestPolicy) *FileHostingOriginRequestPolicy {
+		return &v
+	}).(FileHostingOriginRequestPolicyPtrOutput)
+}
+
+// Which cookies are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to none.
+func (o FileHostingOriginRequestPolicyOutput) CookieBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingOriginRequestPolicy) *string { return v.CookieBehavior }).(pulumi.StringPtrOutput)
+}
+
+// The cookies the cookie behavior applies to.
+func (o FileHostingOriginRequestPolicyOutput) Cookies() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingOriginRequestPolicy) []string { return v.Cookies }).(pulumi.StringArrayOutput)
+}
+
+// Which headers are forwarded to the bucket: none, whitelist, allViewer, allViewerAndWhitelistCloudFront or allExcept. Defaults to whitelist.
+func (o FileHostingOriginRequestPolicyOutput) HeaderBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingOriginRequestPolicy) *string { return v.HeaderBehavior }).(pulumi.StringPtrOutput)
+}
+
+// The headers the header behavior applies to. Defaults to Content-Type.
+func (o FileHostingOriginRequestPolicyOutput) Headers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingOriginRequestPolicy) []string { return v.Headers }).(pulumi.StringArrayOutput)
+}
+
+// Which query strings are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to whitelist.
+func (o FileHostingOriginRequestPolicyOutput) QueryStringBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingOriginRequestPolicy) *string { return v.QueryStringBehavior }).(pulumi.StringPtrOutput)
+}
+
+// The query strings the query string behavior applies to. Defaults to partNumber and uploadId, which multipart uploads need.
+func (o FileHostingOriginRequestPolicyOutput) QueryStrings() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v FileHostingOriginRequestPolicy) []string { return v.QueryStrings }).(pulumi.StringArrayOutput)
+}
+
+type FileHostingOriginRequestPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingOriginRequestPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingOriginRequestPolicy)(nil)).Elem()
+}
+
+func (o FileHostingOriginRequestPolicyPtrOutput) ToFileHostingOriginRequestPolicyPtrOutput() FileHostingOriginRequestPolicyPtrOutput {
+	return o
+}
+
+func (o FileHostingOriginRequestPolicyPtrOutput) ToFileHostingOriginRequestPolicyPtrOutputWithContext(ctx context.Context) FileHostingOriginRequestPolicyPtrOutput {
+	return o
+}
+
+func (o FileHostingOriginRequestPolicyPtrOutput) Elem() FileHostingOriginRequestPolicyOutput {
+	return o.ApplyT(func(v *FileHostingOriginRequestPolicy) FileHostingOriginRequestPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingOriginRequestPolicy
+		return ret
+	}).(FileHostingOriginRequestPolicyOutput)
+}
+
+// Which cookies are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to none.
+func (o FileHostingOriginRequestPolicyPtrOutput) CookieBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingOriginRequestPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.CookieBehavior
+	}).(pulumi.StringPtrOutput)
+}
+
+// The cookies the cookie behavior applies to.
+func (o FileHostingOriginRequestPolicyPtrOutput) Cookies() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingOriginRequestPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Cookies
+	}).(pulumi.StringArrayOutput)
+}
+
+// Which headers are forwarded to the bucket: none, whitelist, allViewer, allViewerAndWhitelistCloudFront or allExcept. Defaults to whitelist.
+func (o FileHostingOriginRequestPolicyPtrOutput) HeaderBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingOriginRequestPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.HeaderBehavior
+	}).(pulumi.StringPtrOutput)
+}
+
+// The headers the header behavior applies to. Defaults to Content-Type.
+func (o FileHostingOriginRequestPolicyPtrOutput) Headers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingOriginRequestPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Headers
+	}).(pulumi.StringArrayOutput)
+}
+
+// Which query strings are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to whitelist.
+func (o FileHostingOriginRequestPolicyPtrOutput) QueryStringBehavior() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingOriginRequestPolicy) *string {
+		if v == nil {
+			return nil
+		}
+		return v.QueryStringBehavior
+	}).(pulumi.StringPtrOutput)
+}
+
+// The query strings the query string behavior applies to. Defaults to partNumber and uploadId, which multipart uploads need.
+func (o FileHostingOriginRequestPolicyPtrOutput) QueryStrings() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *FileHostingOriginRequestPolicy) []string {
+		if v == nil {
+			return nil
+		}
+		return v.QueryStrings
+	}).(pulumi.StringArrayOutput)
+}
+
+// The security headers of the response headers policy of a FileHosting.
+type FileHostingSecurityHeaders struct {
+	// The Content-Security-Policy header. Defaults to default-src 'none', so hosted files cannot load or run anything when opened directly.
+	ContentSecurityPolicy *string `pulumi:"contentSecurityPolicy"`
+	// Whether the Strict-Transport-Security header includes subdomains. Defaults to true.
+	StrictTransportSecurityIncludeSubdomains *bool `pulumi:"strictTransportSecurityIncludeSubdomains"`
+	// The max age of the Strict-Transport-Security header, in seconds. Defaults to 31536000.
+	StrictTransportSecurityMaxAge *int `pulumi:"strictTransportSecurityMaxAge"`
+	// Whether the Strict-Transport-Security header allows preloading. Defaults to false.
+	StrictTransportSecurityPreload *bool `pulumi:"strictTransportSecurityPreload"`
+}
+
+// FileHostingSecurityHeadersInput is an input type that accepts FileHostingSecurityHeadersArgs and FileHostingSecurityHeadersOutput values.
+// You can construct a concrete instance of `FileHostingSecurityHeadersInput` via:
+//
+//	FileHostingSecurityHeadersArgs{...}
+type FileHostingSecurityHeadersInput interface {
+	pulumi.Input
+
+	ToFileHostingSecurityHeadersOutput() FileHostingSecurityHeadersOutput
+	ToFileHostingSecurityHeadersOutputWithContext(context.Context) FileHostingSecurityHeadersOutput
+}
+
+// The security headers of the response headers policy of a FileHosting.
+type FileHostingSecurityHeadersArgs struct {
+	// The Content-Security-Policy header. Defaults to default-src 'none', so hosted files cannot load or run anything when opened directly.
+	ContentSecurityPolicy pulumi.StringPtrInput `pulumi:"contentSecurityPolicy"`
+	// Whether the Strict-Transport-Security header includes subdomains. Defaults to true.
+	StrictTransportSecurityIncludeSubdomains pulumi.BoolPtrInput `pulumi:"strictTransportSecurityIncludeSubdomains"`
+	// The max age of the Strict-Transport-Security header, in seconds. Defaults to 31536000.
+	StrictTransportSecurityMaxAge pulumi.IntPtrInput `pulumi:"strictTransportSecurityMaxAge"`
+	// Whether the Strict-Transport-Security header allows preloading. Defaults to false.
+	StrictTransportSecurityPreload pulumi.BoolPtrInput `pulumi:"strictTransportSecurityPreload"`
+}
+
+func (FileHostingSecurityHeadersArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingSecurityHeaders)(nil)).Elem()
+}
+
+func (i FileHostingSecurityHeadersArgs) ToFileHostingSecurityHeadersOutput() FileHostingSecurityHeadersOutput {
+	return i.ToFileHostingSecurityHeadersOutputWithContext(context.Background())
+}
+
+func (i FileHostingSecurityHeadersArgs) ToFileHostingSecurityHeadersOutputWithContext(ctx context.Context) FileHostingSecurityHeadersOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingSecurityHeadersOutput)
+}
+
+func (i FileHostingSecurityHeadersArgs) ToFileHostingSecurityHeadersPtrOutput() FileHostingSecurityHeadersPtrOutput {
+	return i.ToFileHostingSecurityHeadersPtrOutputWithContext(context.Background())
+}
+
+func (i FileHostingSecurityHeadersArgs) ToFileHostingSecurityHeadersPtrOutputWithContext(ctx context.Context) FileHostingSecurityHeadersPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingSecurityHeadersOutput).ToFileHostingSecurityHeadersPtrOutputWithContext(ctx)
+}
+
+// FileHostingSecurityHeadersPtrInput is an input type that accepts FileHostingSecurityHeadersArgs, FileHostingSecurityHeadersPtr and FileHostingSecurityHeadersPtrOutput values.
+// You can construct a concrete instance of `FileHostingSecurityHeadersPtrInput` via:
+//
+//	        FileHostingSecurityHeadersArgs{...}
+//
+//	or:
+//
+//	        nil
+type FileHostingSecurityHeadersPtrInput interface {
+	pulumi.Input
+
+	ToFileHostingSecurityHeadersPtrOutput() FileHostingSecurityHeadersPtrOutput
+	ToFileHostingSecurityHeadersPtrOutputWithContext(context.Context) FileHostingSecurityHeadersPtrOutput
+}
+
+type fileHostingSecurityHeadersPtrType FileHostingSecurityHeadersArgs
+
+func FileHostingSecurityHeadersPtr(v *FileHostingSecurityHeadersArgs) FileHostingSecurityHeadersPtrInput {
+	return (*fileHostingSecurityHeadersPtrType)(v)
+}
+
+func (*fileHostingSecurityHeadersPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingSecurityHeaders)(nil)).Elem()
+}
+
+func (i *fileHostingSecurityHeadersPtrType) ToFileHostingSecurityHeadersPtrOutput() FileHostingSecurityHeadersPtrOutput {
+	return i.ToFileHostingSecurityHeadersPtrOutputWithContext(context.Background())
+}
+
+func (i *fileHostingSecurityHeadersPtrType) ToFileHostingSecurityHeadersPtrOutputWithContext(ctx context.Context) FileHostingSecurityHeadersPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FileHostingSecurityHeadersPtrOutput)
+}
+
+// The security headers of the response headers policy of a FileHosting.
+type FileHostingSecurityHeadersOutput struct{ *pulumi.OutputState }
+
+func (FileHostingSecurityHeadersOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FileHostingSecurityHeaders)(nil)).Elem()
+}
+
+func (o FileHostingSecurityHeadersOutput) ToFileHostingSecurityHeadersOutput() FileHostingSecurityHeadersOutput {
+	return o
+}
+
+func (o FileHostingSecurityHeadersOutput) ToFileHostingSecurityHeadersOutputWithContext(ctx context.Context) FileHostingSecurityHeadersOutput {
+	return o
+}
+
+func (o FileHostingSecurityHeadersOutput) ToFileHostingSecurityHeadersPtrOutput() FileHostingSecurityHeadersPtrOutput {
+	return o.ToFileHostingSecurityHeadersPtrOutputWithContext(context.Background())
+}
+
+func (o FileHostingSecurityHeadersOutput) ToFileHostingSecurityHeadersPtrOutputWithContext(ctx context.Context) FileHostingSecurityHeadersPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v FileHostingSecurityHeaders) *FileHostingSecurityHeaders {
+		return &v
+	}).(FileHostingSecurityHeadersPtrOutput)
+}
+
+// The Content-Security-Policy header. Defaults to default-src 'none', so hosted files cannot load or run anything when opened directly.
+func (o FileHostingSecurityHeadersOutput) ContentSecurityPolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v FileHostingSecurityHeaders) *string { return v.ContentSecurityPolicy }).(pulumi.StringPtrOutput)
+}
+
+// Whether the Strict-Transport-Security header includes subdomains. Defaults to true.
+func (o FileHostingSecurityHeadersOutput) StrictTransportSecurityIncludeSubdomains() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingSecurityHeaders) *bool { return v.StrictTransportSecurityIncludeSubdomains }).(pulumi.BoolPtrOutput)
+}
+
+// The max age of the Strict-Transport-Security header, in seconds. Defaults to 31536000.
+func (o FileHostingSecurityHeadersOutput) StrictTransportSecurityMaxAge() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v FileHostingSecurityHeaders) *int { return v.StrictTransportSecurityMaxAge }).(pulumi.IntPtrOutput)
+}
+
+// Whether the Strict-Transport-Security header allows preloading. Defaults to false.
+func (o FileHostingSecurityHeadersOutput) StrictTransportSecurityPreload() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v FileHostingSecurityHeaders) *bool { return v.StrictTransportSecurityPreload }).(pulumi.BoolPtrOutput)
+}
+
+type FileHostingSecurityHeadersPtrOutput struct{ *pulumi.OutputState }
+
+func (FileHostingSecurityHeadersPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FileHostingSecurityHeaders)(nil)).Elem()
+}
+
+func (o FileHostingSecurityHeadersPtrOutput) ToFileHostingSecurityHeadersPtrOutput() FileHostingSecurityHeadersPtrOutput {
+	return o
+}
+
+func (o FileHostingSecurityHeadersPtrOutput) ToFileHostingSecurityHeadersPtrOutputWithContext(ctx context.Context) FileHostingSecurityHeadersPtrOutput {
+	return o
+}
+
+func (o FileHostingSecurityHeadersPtrOutput) Elem() FileHostingSecurityHeadersOutput {
+	return o.ApplyT(func(v *FileHostingSecurityHeaders) FileHostingSecurityHeaders {
+		if v != nil {
+			return *v
+		}
+		var ret FileHostingSecurityHeaders
+		return ret
+	}).(FileHostingSecurityHeadersOutput)
+}
+
+// The Content-Security-Policy header. Defaults to default-src 'none', so hosted files cannot load or run anything when opened directly.
+func (o FileHostingSecurityHeadersPtrOutput) ContentSecurityPolicy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FileHostingSecurityHeaders) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ContentSecurityPolicy
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether the Strict-Transport-Security header includes subdomains. Defaults to true.
+func (o FileHostingSecurityHeadersPtrOutput) StrictTransportSecurityIncludeSubdomains() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingSecurityHeaders) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.StrictTransportSecurityIncludeSubdomains
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The max age of the Strict-Transport-Security header, in seconds. Defaults to 31536000.
+func (o FileHostingSecurityHeadersPtrOutput) StrictTransportSecurityMaxAge() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *FileHostingSecurityHeaders) *int {
+		if v == nil {
+			return nil
+		}
+		return v.StrictTransportSecurityMaxAge
+	}).(pulumi.IntPtrOutput)
+}
+
+// Whether the Strict-Transport-Security header allows preloading. Defaults to false.
+func (o FileHostingSecurityHeadersPtrOutput) StrictTransportSecurityPreload() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *FileHostingSecurityHeaders) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.StrictTransportSecurityPreload
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The settings of a WAF web ACL created for a CloudFront distribution.
+type WebAcl struct {
+	// The IP addresses and CIDR ranges that are allowed. If provided, all other requests are blocked.
+	AllowedIpAddresses []string `pulumi:"allowedIpAddresses"`
+	// The IP addresses and CIDR ranges that are blocked.
+	BlockedIpAddresses []string `pulumi:"blockedIpAddresses"`
+	// The names of the AWS managed rule groups to apply. Defaults to AWSManagedRulesAmazonIpReputationList and AWSManagedRulesKnownBadInputsRuleSet.
+	ManagedRuleGroups []string `pulumi:"managedRuleGroups"`
+	// The maximum number of requests a single IP address may make in 5 minutes before it is blocked. Defaults to 2000, 0 disables rate limiting.
+	RateLimit *int `pulumi:"rateLimit"`
+}
+
+// WebAclInput is an input type that accepts WebAclArgs and WebAclOutput values.
+// You can construct a concrete instance of `WebAclInput` via:
+//
+//	WebAclArgs{...}
+type WebAclInput interface {
+	pulumi.Input
+
+	ToWebAclOutput() WebAclOutput
+	ToWebAclOutputWithContext(context.Context) WebAclOutput
+}
+
+// The settings of a WAF web ACL created for a CloudFront distribution.
+type WebAclArgs struct {
+	// The IP addresses and CIDR ranges that are allowed. If provided, all other requests are blocked.
+	AllowedIpAddresses []pulumi.StringInput `pulumi:"allowedIpAddresses"`
+	// The IP addresses and CIDR ranges that are blocked.
+	BlockedIpAddresses []pulumi.StringInput `pulumi:"blockedIpAddresses"`
+	// The names of the AWS managed rule groups to apply. Defaults to AWSManagedRulesAmazonIpReputationList and AWSManagedRulesKnownBadInputsRuleSet.
+	ManagedRuleGroups []pulumi.StringInput `pulumi:"managedRuleGroups"`
+	// The maximum number of requests a single IP address may make in 5 minutes before it is blocked. Defaults to 2000, 0 disables rate limiting.
+	RateLimit *int `pulumi:"rateLimit"`
+}
+
+func (WebAclArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*WebAcl)(nil)).Elem()
+}
+
+func (i WebAclArgs) ToWebAclOutput() WebAclOutput {
+	return i.ToWebAclOutputWithContext(context.Background())
+}
+
+func (i WebAclArgs) ToWebAclOutputWithContext(ctx context.Context) WebAclOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WebAclOutput)
+}
+
+func (i WebAclArgs) ToWebAclPtrOutput() WebAclPtrOutput {
+	return i.ToWebAclPtrOutputWithContext(context.Background())
+}
+
+func (i WebAclArgs) ToWebAclPtrOutputWithContext(ctx context.Context) WebAclPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WebAclOutput).ToWebAclPtrOutputWithContext(ctx)
+}
+
+// WebAclPtrInput is an input type that accepts WebAclArgs, WebAclPtr and WebAclPtrOutput values.
+// You can construct a concrete instance of `WebAclPtrInput` via:
+//
+//	        WebAclArgs{...}
+//
+//	or:
+//
+//	        nil
+type WebAclPtrInput interface {
+	pulumi.Input
+
+	ToWebAclPtrOutput() WebAclPtrOutput
+	ToWebAclPtrOutputWithContext(context.Context) WebAclPtrOutput
+}
+
+type webAclPtrType WebAclArgs
+
+func WebAclPtr(v *WebAclArgs) WebAclPtrInput {
+	return (*webAclPtrType)(v)
+}
+
+func (*webAclPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**WebAcl)(nil)).Elem()
+}
+
+func (i *webAclPtrType) ToWebAclPtrOutput() WebAclPtrOutput {
+	return i.ToWebAclPtrOutputWithContext(context.Background())
+}
+
+func (i *webAclPtrType) ToWebAclPtrOutputWithContext(ctx context.Context) WebAclPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WebAclPtrOutput)
+}
+
+// The settings of a WAF web ACL created for a CloudFront distribution.
+type WebAclOutput struct{ *pulumi.OutputState }
+
+func (WebAclOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*WebAcl)(nil)).Elem()
+}
+
+func (o WebAclOutput) ToWebAclOutput() WebAclOutput {
+	return o
+}
+
+func (o WebAclOutput) ToWebAclOutputWithContext(ctx context.Context) WebAclOutput {
+	return o
+}
+
+func (o WebAclOutput) ToWebAclPtrOutput() WebAclPtrOutput {
+	return o.ToWebAclPtrOutputWithContext(context.Background())
+}
+
+func (o WebAclOutput) ToWebAclPtrOutputWithContext(ctx context.Context) WebAclPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v WebAcl) *WebAcl {
+		return &v
+	}).(WebAclPtrOutput)
+}
+
+// The IP addresses and CIDR ranges that are allowed. If provided, all other requests are blocked.
+func (o WebAclOutput) AllowedIpAddresses() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v WebAcl) []string { return v.AllowedIpAddresses }).(pulumi.StringArrayOutput)
+}
+
+// The IP addresses and CIDR ranges that are blocked.
+func (o WebAclOutput) BlockedIpAddresses() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v WebAcl) []string { return v.BlockedIpAddresses }).(pulumi.StringArrayOutput)
+}
+
+// The names of the AWS managed rule groups to apply. Defaults to AWSManagedRulesAmazonIpReputationList and AWSManagedRulesKnownBadInputsRuleSet.
+func (o WebAclOutput) ManagedRuleGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v WebAcl) []string { return v.ManagedRuleGroups }).(pulumi.StringArrayOutput)
+}
+
+// The maximum number of requests a single IP address may make in 5 minutes before it is blocked. Defaults to 2000, 0 disables rate limiting.
+func (o WebAclOutput) RateLimit() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v WebAcl) *int { return v.RateLimit }).(pulumi.IntPtrOutput)
+}
+
+type WebAclPtrOutput struct{ *pulumi.OutputState }
+
+func (WebAclPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**WebAcl)(nil)).Elem()
+}
+
+func (o WebAclPtrOutput) ToWebAclPtrOutput() WebAclPtrOutput {
+	return o
+}
+
+func (o WebAclPtrOutput) ToWebAclPtrOutputWithContext(ctx context.Context) WebAclPtrOutput {
+	return o
+}
+
+func (o WebAclPtrOutput) Elem() WebAclOutput {
+	return o.ApplyT(func(v *WebAcl) WebAcl {
+		if v != nil {
+			return *v
+		}
+		var ret WebAcl
+		return ret
+	}).(WebAclOutput)
+}
+
+// The IP addresses and CIDR ranges that are allowed. If provided, all other requests are blocked.
+func (o WebAclPtrOutput) AllowedIpAddresses() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *WebAcl) []string {
+		if v == nil {
+			return nil
+		}
+		return v.AllowedIpAddresses
+	}).(pulumi.StringArrayOutput)
+}
+
+// The IP addresses and CIDR ranges that are blocked.
+func (o WebAclPtrOutput) BlockedIpAddresses() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *WebAcl) []string {
+		if v == nil {
+			return nil
+		}
+		return v.BlockedIpAddresses
+	}).(pulumi.StringArrayOutput)
+}
+
+// The names of the AWS managed rule groups to apply. Defaults to AWSManagedRulesAmazonIpReputationList and AWSManagedRulesKnownBadInputsRuleSet.
+func (o WebAclPtrOutput) ManagedRuleGroups() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *WebAcl) []string {
+		if v == nil {
+			return nil
+		}
+		return v.ManagedRuleGroups
+	}).(pulumi.StringArrayOutput)
+}
+
+// The maximum number of requests a single IP address may make in 5 minutes before it is blocked. Defaults to 2000, 0 disables rate limiting.
+func (o WebAclPtrOutput) RateLimit() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *WebAcl) *int {
+		if v == nil {
+			return nil
+		}
+		return v.RateLimit
+	}).(pulumi.IntPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingBucketInput)(nil)).Elem(), FileHostingBucketArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingBucketPtrInput)(nil)).Elem(), FileHostingBucketArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingCacheBehaviorInput)(nil)).Elem(), FileHostingCacheBehaviorArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingCacheBehaviorArrayInput)(nil)).Elem(), FileHostingCacheBehaviorArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingCachePolicyInput)(nil)).Elem(), FileHostingCachePolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingCachePolicyPtrInput)(nil)).Elem(), FileHostingCachePolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingCorsInput)(nil)).Elem(), FileHostingCorsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingCorsPtrInput)(nil)).Elem(), FileHostingCorsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingEdgeHandlersInput)(nil)).Elem(), FileHostingEdgeHandlersArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingEdgeHandlersPtrInput)(nil)).Elem(), FileHostingEdgeHandlersArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingFailoverInput)(nil)).Elem(), FileHostingFailoverArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingFailoverPtrInput)(nil)).Elem(), FileHostingFailoverArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingGeoRestrictionInput)(nil)).Elem(), FileHostingGeoRestrictionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingGeoRestrictionPtrInput)(nil)).Elem(), FileHostingGeoRestrictionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingKeyRotationInput)(nil)).Elem(), FileHostingKeyRotationArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingKeyRotationPtrInput)(nil)).Elem(), FileHostingKeyRotationArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingLifecycleRuleInput)(nil)).Elem(), FileHostingLifecycleRuleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingLifecycleRuleArrayInput)(nil)).Elem(), FileHostingLifecycleRuleArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingLifecycleTransitionInput)(nil)).Elem(), FileHostingLifecycleTransitionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingLifecycleTransitionArrayInput)(nil)).Elem(), FileHostingLifecycleTransitionArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingLoggingInput)(nil)).Elem(), FileHostingLoggingArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingLoggingPtrInput)(nil)).Elem(), FileHostingLoggingArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingNotificationsInput)(nil)).Elem(), FileHostingNotificationsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingNotificationsPtrInput)(nil)).Elem(), FileHostingNotificationsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingOriginRequestPolicyInput)(nil)).Elem(), FileHostingOriginRequestPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingOriginRequestPolicyPtrInput)(nil)).Elem(), FileHostingOriginRequestPolicyArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingSecurityHeadersInput)(nil)).Elem(), FileHostingSecurityHeadersArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FileHostingSecurityHeadersPtrInput)(nil)).Elem(), FileHostingSecurityHeadersArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*WebAclInput)(nil)).Elem(), WebAclArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*WebAclPtrInput)(nil)).Elem(), WebAclArgs{})
+	pulumi.RegisterOutputType(DnsRecordOutput{})
+	pulumi.RegisterOutputType(DnsRecordArrayOutput{})
+	pulumi.RegisterOutputType(FileHostingBucketOutput{})
+	pulumi.RegisterOutputType(FileHostingBucketPtrOutput{})
+	pulumi.RegisterOutputType(FileHostingCacheBehaviorOutput{})
+	pulumi.RegisterOutputType(FileHostingCacheBehaviorArrayOutput{})
+	pulumi.RegisterOutputType(FileHostingCachePolicyOutput{})
+	pulumi.RegisterOutputType(FileHostingCachePolicyPtrOutput{})
+	pulumi.RegisterOutputType(FileHostingCorsOutput{})
+	pulumi.RegisterOutputType(FileHostingCorsPtrOutput{})
+	pulumi.RegisterOutputType(FileHostingEdgeHandlersOutput{})
+	pulumi.RegisterOutputType(FileHostingEdgeHandlersPtrOutput{})
+	pulumi.RegisterOutputType(FileHostingFailoverOutput{})
+	pulumi.RegisterOutputType(FileHostingFailoverPtrOutput{})
+	pulumi.RegisterOutputType(FileHostingGeoRestrictionOutput{})
+	pulumi.RegisterOutputType(FileHostingGeoRestrictionPtrOutput{})
+	pulumi.RegisterOutputType(FileHostingKeyRotationOutput{})
+	pulumi.RegisterOutputType(FileHostingKeyRotationPtrOutput{})
+	pulumi.RegisterOutputType(FileHostingLifecycleRuleOutput{})
+	pulumi.RegisterOutputType(FileHostingLifecycleRuleArrayOutput{})
+	pulumi.RegisterOutputType(FileHostingLifecycleTransitionOutput{})
+	pulumi.RegisterOutputType(FileHostingLifecycleTransitionArrayOutput{})
+	pulumi.RegisterOutputType(FileHostingLoggingOutput{})
+	pulumi.RegisterOutputType(FileHostingLoggingPtrOutput{})
+	pulumi.RegisterOutputType(FileHostingNotificationsOutput{})
+	pulumi.RegisterOutputType(FileHostingNotificationsPtrOutput{})
+	pulumi.RegisterOutputType(FileHostingOriginRequestPolicyOutput{})
+	pulumi.RegisterOutputType(FileHostingOriginRequestPolicyPtrOutput{})
+	pulumi.RegisterOutputType(FileHostingSecurityHeadersOutput{})
+	pulumi.RegisterOutputType(FileHostingSecurityHeadersPtrOutput{})
+	pulumi.RegisterOutputType(WebAclOutput{})
+	pulumi.RegisterOutputType(WebAclPtrOutput{})
+}
@@ -0,0 +1,220 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package gotiac
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-gotiac/sdk/go/gotiac/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type S3GraphStore struct {
+	pulumi.ResourceState
+
+	// The ARN of the graph store bucket.
+	BucketArn pulumi.StringOutput `pulumi:"bucketArn"`
+	// The name of the graph store bucket.
+	BucketName pulumi.StringOutput `pulumi:"bucketName"`
+	// The ARN of the role with read access to the nodes, edges and props prefixes.
+	ReaderRoleArn pulumi.StringOutput `pulumi:"readerRoleArn"`
+	// The ARN of the role with read and write access to the nodes, edges and props prefixes.
+	WriterRoleArn pulumi.StringOutput `pulumi:"writerRoleArn"`
+}
+
+// NewS3GraphStore registers a new resource with the given unique name, arguments, and options.
+func NewS3GraphStore(ctx *pulumi.Context,
+	name string, args *S3GraphStoreArgs, opts ...pulumi.ResourceOption) (*S3GraphStore, error) {
+	if args == nil {
+		args = &S3GraphStoreArgs{}
+	}
+
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource S3GraphStore
+	err := ctx.RegisterRemoteComponentResource("gotiac:index:S3GraphStore", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type s3graphStoreArgs struct {
+	// The name of the bucket to create. If not provided, a unique name will be generated.
+	BucketName *string `pulumi:"bucketName"`
+	// Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+	ForceDestroy *bool `pulumi:"forceDestroy"`
+	// The ARN of a KMS key used to encrypt the graph. If not provided, objects are encrypted with S3 managed keys.
+	KmsKeyArn *string `pulumi:"kmsKeyArn"`
+	// The number of days noncurrent object versions are kept. Defaults to 30.
+	NoncurrentVersionExpirationDays *int `pulumi:"noncurrentVersionExpirationDays"`
+}
+
+// The set of arguments for constructing a S3GraphStore resource.
+type S3GraphStoreArgs struct {
+	// The name of the bucket to create. If not provided, a unique name will be generated.
+	BucketName pulumi.StringPtrInput
+	// Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+	ForceDestroy pulumi.BoolPtrInput
+	// The ARN of a KMS key used to encrypt the graph. If not provided, objects are encrypted with S3 managed keys.
+	KmsKeyArn pulumi.StringPtrInput
+	// The number of days noncurrent object versions are kept. Defaults to 30.
+	NoncurrentVersionExpirationDays pulumi.IntPtrInput
+}
+
+func (S3GraphStoreArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*s3graphStoreArgs)(nil)).Elem()
+}
+
+type S3GraphStoreInput interface {
+	pulumi.Input
+
+	ToS3GraphStoreOutput() S3GraphStoreOutput
+	ToS3GraphStoreOutputWithContext(ctx context.Context) S3GraphStoreOutput
+}
+
+func (*S3GraphStore) ElementType() reflect.Type {
+	return reflect.TypeOf((**S3GraphStore)(nil)).Elem()
+}
+
+func (i *S3GraphStore) ToS3GraphStoreOutput() S3GraphStoreOutput {
+	return i.ToS3GraphStoreOutputWithContext(context.Background())
+}
+
+func (i *S3GraphStore) ToS3GraphStoreOutputWithContext(ctx context.Context) S3GraphStoreOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(S3GraphStoreOutput)
+}
+
+// S3GraphStoreArrayInput is an input type that accepts S3GraphStoreArray and S3GraphStoreArrayOutput values.
+// You can construct a concrete instance of `S3GraphStoreArrayInput` via:
+//
+//	S3GraphStoreArray{ S3GraphStoreArgs{...} }
+type S3GraphStoreArrayInput interface {
+	pulumi.Input
+
+	ToS3GraphStoreArrayOutput() S3GraphStoreArrayOutput
+	ToS3GraphStoreArrayOutputWithContext(context.Context) S3GraphStoreArrayOutput
+}
+
+type S3GraphStoreArray []S3GraphStoreInput
+
+func (S3GraphStoreArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*S3GraphStore)(nil)).Elem()
+}
+
+func (i S3GraphStoreArray) ToS3GraphStoreArrayOutput() S3GraphStoreArrayOutput {
+	return i.ToS3GraphStoreArrayOutputWithContext(context.Background())
+}
+
+func (i S3GraphStoreArray) ToS3GraphStoreArrayOutputWithContext(ctx context.Context) S3GraphStoreArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(S3GraphStoreArrayOutput)
+}
+
+// S3GraphStoreMapInput is an input type that accepts S3GraphStoreMap and S3GraphStoreMapOutput values.
+// You can construct a concrete instance of `S3GraphStoreMapInput` via:
+//
+//	S3GraphStoreMap{ "key": S3GraphStoreArgs{...} }
+type S3GraphStoreMapInput interface {
+	pulumi.Input
+
+	ToS3GraphStoreMapOutput() S3GraphStoreMapOutput
+	ToS3GraphStoreMapOutputWithContext(context.Context) S3GraphStoreMapOutput
+}
+
+type S3GraphStoreMap map[string]S3GraphStoreInput
+
+func (S3GraphStoreMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*S3GraphStore)(nil)).Elem()
+}
+
+func (i S3GraphStoreMap) ToS3GraphStoreMapOutput() S3GraphStoreMapOutput {
+	return i.ToS3GraphStoreMapOutputWithContext(context.Background())
+}
+
+func (i S3GraphStoreMap) ToS3GraphStoreMapOutputWithContext(ctx context.Context) S3GraphStoreMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(S3GraphStoreMapOutput)
+}
+
+type S3GraphStoreOutput struct{ *pulumi.OutputState }
+
+func (S3GraphStoreOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**S3GraphStore)(nil)).Elem()
+}
+
+func (o S3GraphStoreOutput) ToS3GraphStoreOutput() S3GraphStoreOutput {
+	return o
+}
+
+func (o S3GraphStoreOutput) ToS3GraphStoreOutputWithContext(ctx context.Context) S3GraphStoreOutput {
+	return o
+}
+
+// The ARN of the graph store bucket.
+func (o S3GraphStoreOutput) BucketArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *S3GraphStore) pulumi.StringOutput { return v.BucketArn }).(pulumi.StringOutput)
+}
+
+// The name of the graph store bucket.
+func (o S3GraphStoreOutput) BucketName() pulumi.StringOutput {
+	return o.ApplyT(func(v *S3GraphStore) pulumi.StringOutput { return v.BucketName }).(pulumi.StringOutput)
+}
+
+// The ARN of the role with read access to the nodes, edges and props prefixes.
+func (o S3GraphStoreOutput) ReaderRoleArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *S3GraphStore) pulumi.StringOutput { return v.ReaderRoleArn }).(pulumi.StringOutput)
+}
+
+// The ARN of the role with read and write access to the nodes, edges and props prefixes.
+func (o S3GraphStoreOutput) WriterRoleArn() pulumi.StringOutput {
+	return o.ApplyT(func(v *S3GraphStore) pulumi.StringOutput { return v.WriterRoleArn }).(pulumi.StringOutput)
+}
+
+type S3GraphStoreArrayOutput struct{ *pulumi.OutputState }
+
+func (S3GraphStoreArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*S3GraphStore)(nil)).Elem()
+}
+
+func (o S3GraphStoreArrayOutput) ToS3GraphStoreArrayOutput() S3GraphStoreArrayOutput {
+	return o
+}
+
+func (o S3GraphStoreArrayOutput) ToS3GraphStoreArrayOutputWithContext(ctx context.Context) S3GraphStoreArrayOutput {
+	return o
+}
+
+func (o S3GraphStoreArrayOutput) Index(i pulumi.IntInput) S3GraphStoreOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *S3GraphStore {
+		return vs[0].([]*S3GraphStore)[vs[1].(int)]
+	}).(S3GraphStoreOutput)
+}
+
+type S3GraphStoreMapOutput struct{ *pulumi.OutputState }
+
+func (S3GraphStoreMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*S3GraphStore)(nil)).Elem()
+}
+
+func (o S3GraphStoreMapOutput) ToS3GraphStoreMapOutput() S3GraphStoreMapOutput {
+	return o
+}
+
+func (o S3GraphStoreMapOutput) ToS3GraphStoreMapOutputWithContext(ctx context.Context) S3GraphStoreMapOutput {
+	return o
+}
+
+func (o S3GraphStoreMapOutput) MapIndex(k pulumi.StringInput) S3GraphStoreOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *S3GraphStore {
+		return vs[0].(map[string]*S3GraphStore)[vs[1].(string)]
+	}).(S3GraphStoreOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*S3GraphStoreInput)(nil)).Elem(), &S3GraphStore{})
+	pulumi.RegisterInputType(reflect.TypeOf((*S3GraphStoreArrayInput)(nil)).Elem(), S3GraphStoreArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*S3GraphStoreMapInput)(nil)).Elem(), S3GraphStoreMap{})
+	pulumi.RegisterOutputType(S3GraphStoreOutput{})
+	pulumi.RegisterOutputType(S3GraphStoreArrayOutput{})
+	pulumi.RegisterOutputType(S3GraphStoreMapOutput{})
+}
@@ -2,6 +2,8 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
 import * as utilities from "./utilities";
 
 export class FileHosting extends pulumi.ComponentResource {
@@ -20,17 +22,97 @@ export class FileHosting extends pulumi.ComponentResource {
     }
 
     /**
-     * The ID the private key.
+     * The ARN of the bucket the files are served from.
+     */
+    public /*out*/ readonly bucketArn!: pulumi.Output<string>;
+    /**
+     * The name of the bucket the files are served from.
+     */
+    public readonly bucketName!: pulumi.Output<string>;
+    /**
+     * The IAM policy document that allows listing and reading the files of the bucket, including decrypting them with the KMS key of the bucket if it is created with SSE-KMS or bucketKmsKeyArn is set.
+     */
+    public /*out*/ readonly bucketReaderPolicy!: pulumi.Output<string>;
+    /**
+     * The ARN of the managed bucket reader policy. Empty unless managedPolicies is set.
+     */
+    public /*out*/ readonly bucketReaderPolicyArn!: pulumi.Output<string>;
+    /**
+     * The IAM policy document that allows listing, reading, writing and deleting the files of the bucket, including using the KMS key of the bucket if it is created with SSE-KMS or bucketKmsKeyArn is set.
+     */
+    public /*out*/ readonly bucketWriterPolicy!: pulumi.Output<string>;
+    /**
+     * The ARN of the managed bucket writer policy. Empty unless managedPolicies is set.
+     */
+    public /*out*/ readonly bucketWriterPolicyArn!: pulumi.Output<string>;
+    /**
+     * The ARN of the certificate of the distribution.
+     */
+    public readonly certificateArn!: pulumi.Output<string>;
+    /**
+     * The ARN of the CloudFront distribution.
+     */
+    public /*out*/ readonly distributionArn!: pulumi.Output<string>;
+    /**
+     * The domain name of the CloudFront distribution.
+     */
+    public /*out*/ readonly distributionDomainName!: pulumi.Output<string>;
+    /**
+     * The ID of the CloudFront distribution.
+     */
+    public /*out*/ readonly distributionId!: pulumi.Output<string>;
+    /**
+     * The ID of the key group trusted to sign URLs and cookies.
+     */
+    public /*out*/ readonly keyGroupId!: pulumi.Output<string>;
+    /**
+     * The name of the bucket the access logs are written to. Empty unless logging is configured.
+     */
+    public /*out*/ readonly logBucketName!: pulumi.Output<string>;
+    /**
+     * The ARN of the queue the event notifications are sent to. Empty unless the component creates the queue.
+     */
+    public /*out*/ readonly notificationQueueArn!: pulumi.Output<string>;
+    /**
+     * The ARN of the topic the event notifications are published to. Empty unless the component creates the topic.
+     */
+    public /*out*/ readonly notificationTopicArn!: pulumi.Output<string>;
+    /**
+     * The ID of the origin access control CloudFront reads the bucket with.
+     */
+    public /*out*/ readonly originAccessControlId!: pulumi.Output<string>;
+    /**
+     * The ID of the public key of the active key generation.
      */
     public /*out*/ readonly privateKeyId!: pulumi.Output<string>;
     /**
-     * The parameter name for the private key.
+     * The parameter name for the private key of the active key generation.
      */
     public /*out*/ readonly privateKeyParameterName!: pulumi.Output<string>;
+    /**
+     * The IAM policy document that allows reading the private keys to sign URLs and cookies.
+     */
+    public /*out*/ readonly signerPolicy!: pulumi.Output<string>;
+    /**
+     * The ARN of the managed signer policy. Empty unless managedPolicies is set.
+     */
+    public /*out*/ readonly signerPolicyArn!: pulumi.Output<string>;
+    /**
+     * The ID of the public key of the active upload key generation. Empty unless the upload mode is signed-upload.
+     */
+    public /*out*/ readonly uploadPrivateKeyId!: pulumi.Output<string>;
+    /**
+     * The parameter name for the private key of the active upload key generation. Empty unless the upload mode is signed-upload.
+     */
+    public /*out*/ readonly uploadPrivateKeyParameterName!: pulumi.Output<string>;
     /**
      * The file hosting URL.
      */
     public /*out*/ readonly url!: pulumi.Output<string>;
+    /**
+     * The certificate validation records to create when DNS is not managed by the component.
+     */
+    public /*out*/ readonly validationRecords!: pulumi.Output<outputs.DnsRecord[]>;
 
     /**
      * Create a FileHosting resource with the given unique name, arguments, and options.
@@ -46,31 +128,362 @@ export class FileHosting extends pulumi.ComponentResource {
             if ((!args || args.domain === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'domain'");
             }
+            resourceInputs["aliases"] = args ? args.aliases : undefined;
+            resourceInputs["bucketKmsKeyArn"] = args ? args.bucketKmsKeyArn : undefined;
             resourceInputs["bucketName"] = args ? args.bucketName : undefined;
+            resourceInputs["bucketOptions"] = args ? args.bucketOptions : undefined;
+            resourceInputs["bucketPolicyMode"] = args ? args.bucketPolicyMode : undefined;
+            resourceInputs["bucketRegion"] = args ? args.bucketRegion : undefined;
+            resourceInputs["cacheBehaviors"] = args ? args.cacheBehaviors : undefined;
+            resourceInputs["cachePolicy"] = args ? args.cachePolicy : undefined;
+            resourceInputs["cachePolicyId"] = args ? args.cachePolicyId : undefined;
+            resourceInputs["certificateArn"] = args ? args.certificateArn : undefined;
+            resourceInputs["certificateValidated"] = args ? args.certificateValidated : undefined;
+            resourceInputs["cors"] = args ? args.cors : undefined;
             resourceInputs["domain"] = args ? args.domain : undefined;
+            resourceInputs["edgeHandlers"] = args ? args.edgeHandlers : undefined;
+            resourceInputs["failover"] = args ? args.failover : undefined;
+            resourceInputs["geoRestriction"] = args ? args.geoRestriction : undefined;
+            resourceInputs["hostedZoneId"] = args ? args.hostedZoneId : undefined;
+            resourceInputs["ipv6Enabled"] = args ? args.ipv6Enabled : undefined;
+            resourceInputs["keyRotation"] = args ? args.keyRotation : undefined;
+            resourceInputs["logging"] = args ? args.logging : undefined;
+            resourceInputs["manageDns"] = args ? args.manageDns : undefined;
+            resourceInputs["manageOwnershipControls"] = args ? args.manageOwnershipControls : undefined;
+            resourceInputs["managePublicAccessBlock"] = args ? args.managePublicAccessBlock : undefined;
+            resourceInputs["managedPolicies"] = args ? args.managedPolicies : undefined;
+            resourceInputs["notifications"] = args ? args.notifications : undefined;
+            resourceInputs["originRequestPolicy"] = args ? args.originRequestPolicy : undefined;
+            resourceInputs["originRequestPolicyId"] = args ? args.originRequestPolicyId : undefined;
+            resourceInputs["originShieldRegion"] = args ? args.originShieldRegion : undefined;
+            resourceInputs["priceClass"] = args ? args.priceClass : undefined;
+            resourceInputs["securityHeaders"] = args ? args.securityHeaders : undefined;
+            resourceInputs["uploadMode"] = args ? args.uploadMode : undefined;
+            resourceInputs["uploadPathPattern"] = args ? args.uploadPathPattern : undefined;
+            resourceInputs["webAcl"] = args ? args.webAcl : undefined;
+            resourceInputs["webAclArn"] = args ? args.webAclArn : undefined;
+            resourceInputs["bucketArn"] = undefined /*out*/;
+            resourceInputs["bucketReaderPolicy"] = undefined /*out*/;
+            resourceInputs["bucketReaderPolicyArn"] = undefined /*out*/;
+            resourceInputs["bucketWriterPolicy"] = undefined /*out*/;
+            resourceInputs["bucketWriterPolicyArn"] = undefined /*out*/;
+            resourceInputs["distributionArn"] = undefined /*out*/;
+            resourceInputs["distributionDomainName"] = undefined /*out*/;
+            resourceInputs["distributionId"] = undefined /*out*/;
+            resourceInputs["keyGroupId"] = undefined /*out*/;
+            resourceInputs["logBucketName"] = undefined /*out*/;
+            resourceInputs["notificationQueueArn"] = undefined /*out*/;
+            resourceInputs["notificationTopicArn"] = undefined /*out*/;
+            resourceInputs["originAccessControlId"] = undefined /*out*/;
             resourceInputs["privateKeyId"] = undefined /*out*/;
             resourceInputs["privateKeyParameterName"] = undefined /*out*/;
+            resourceInputs["signerPolicy"] = undefined /*out*/;
+            resourceInputs["signerPolicyArn"] = undefined /*out*/;
+            resourceInputs["uploadPrivateKeyId"] = undefined /*out*/;
+            resourceInputs["uploadPrivateKeyParameterName"] = undefined /*out*/;
             resourceInputs["url"] = undefined /*out*/;
+            resourceInputs["validationRecords"] = undefined /*out*/;
         } else {
+            resourceInputs["bucketArn"] = undefined /*out*/;
+            resourceInputs["bucketName"] = undefined /*out*/;
+            resourceInputs["bucketReaderPolicy"] = undefined /*out*/;
+            resourceInputs["bucketReaderPolicyArn"] = undefined /*out*/;
+            resourceInputs["bucketWriterPolicy"] = undefined /*out*/;
+            resourceInputs["bucketWriterPolicyArn"] = undefined /*out*/;
+            resourceInputs["certificateArn"] = undefined /*out*/;
+            resourceInputs["distributionArn"] = undefined /*out*/;
+            resourceInputs["distributionDomainName"] = undefined /*out*/;
+            resourceInputs["distributionId"] = undefined /*out*/;
+            resourceInputs["keyGroupId"] = undefined /*out*/;
+            resourceInputs["logBucketName"] = undefined /*out*/;
+            resourceInputs["notificationQueueArn"] = undefined /*out*/;
+            resourceInputs["notificationTopicArn"] = undefined /*out*/;
+            resourceInputs["originAccessControlId"] = undefined /*out*/;
             resourceInputs["privateKeyId"] = undefined /*out*/;
             resourceInputs["privateKeyParameterName"] = undefined /*out*/;
+            resourceInputs["signerPolicy"] = undefined /*out*/;
+            resourceInputs["signerPolicyArn"] = undefined /*out*/;
+            resourceInputs["uploadPrivateKeyId"] = undefined /*out*/;
+            resourceInputs["uploadPrivateKeyParameterName"] = undefined /*out*/;
             resourceInputs["url"] = undefined /*out*/;
+            resourceInputs["validationRecords"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(FileHosting.__pulumiType, name, resourceInputs, opts, true /*remote*/);
     }
+
+    /**
+     * Invalidates paths of the file hosting distribution and waits until the invalidation has completed. Every update of the stack creates a new invalidation, previews create none. The invalidation is created with the credentials of the default AWS provider of the stack.
+     */
+    invalidate(args: FileHosting.InvalidateArgs): pulumi.Output<FileHosting.InvalidateResult> {
+        return pulumi.runtime.call("gotiac:index:FileHosting/invalidate", {
+            "__self__": this,
+            "paths": args.paths,
+        }, this);
+    }
+
+    /**
+     * Creates the CloudFront signed cookies granting access to files of the file hosting.
+     */
+    signCookies(args: FileHosting.SignCookiesArgs): pulumi.Output<FileHosting.SignCookiesResult> {
+        return pulumi.runtime.call("gotiac:index:FileHosting/signCookies", {
+            "__self__": this,
+            "expiresAt": args.expiresAt,
+            "ipAddress": args.ipAddress,
+            "path": args.path,
+            "startsAt": args.startsAt,
+            "upload": args.upload,
+        }, this);
+    }
+
+    /**
+     * Creates a CloudFront signed URL for a file of the file hosting.
+     */
+    signUrl(args: FileHosting.SignUrlArgs): pulumi.Output<FileHosting.SignUrlResult> {
+        return pulumi.runtime.call("gotiac:index:FileHosting/signUrl", {
+            "__self__": this,
+            "expiresAt": args.expiresAt,
+            "ipAddress": args.ipAddress,
+            "path": args.path,
+            "startsAt": args.startsAt,
+            "upload": args.upload,
+        }, this);
+    }
 }
 
 /**
  * The set of arguments for constructing a FileHosting resource.
  */
 export interface FileHostingArgs {
+    /**
+     * Additional domains the files are served under. They are added to the certificate as subject alternative names.
+     */
+    aliases?: pulumi.Input<string>[];
+    /**
+     * The ARN of the KMS key the existing bucket is encrypted with, if it uses SSE-KMS. It is added to the bucket reader and writer policies. The key policy has to allow the distribution and the roles to use the key. Requires bucketName.
+     */
+    bucketKmsKeyArn?: pulumi.Input<string>;
     /**
      * The name of an existing s3 Bucket to link as origin. If not provided, a new bucket will be created.
      */
     bucketName?: pulumi.Input<string>;
+    /**
+     * The settings of the bucket that is created when no bucket name is given. Conflicts with bucketName.
+     */
+    bucketOptions?: inputs.FileHostingBucketArgs;
+    /**
+     * How the bucket policy is written: replace overwrites the whole policy, merge keeps the statements of other systems and only adds or updates the statements of the component. Merge requires bucketName. Defaults to replace.
+     */
+    bucketPolicyMode?: string;
+    /**
+     * The region of the bucket. The bucket and its policy are managed with a provider for this region. Defaults to the region of the stack.
+     */
+    bucketRegion?: pulumi.Input<string>;
+    /**
+     * Additional cache behaviors for path patterns, in order of precedence. Paths that match none of the patterns use the default behavior, which requires signed URLs or cookies.
+     */
+    cacheBehaviors?: pulumi.Input<inputs.FileHostingCacheBehaviorArgs>[];
+    /**
+     * The settings of the cache policy to create. Conflicts with cachePolicyId.
+     */
+    cachePolicy?: inputs.FileHostingCachePolicyArgs;
+    /**
+     * The ID of an existing cache policy to use instead of creating one.
+     */
+    cachePolicyId?: pulumi.Input<string>;
+    /**
+     * The ARN of an existing ACM certificate in us-east-1 covering the domain. If not provided, a new certificate will be issued and validated.
+     */
+    certificateArn?: pulumi.Input<string>;
+    /**
+     * Whether the validation records of a new certificate have been created in the external DNS provider. Without managed DNS and certificateArn, deploying takes two updates: the first only creates the certificate and returns its validation records, the second, with this set, waits for the validation and creates the distribution. Defaults to false.
+     */
+    certificateValidated?: boolean;
+    /**
+     * The CORS settings of the response headers policy. If not provided, all origins are allowed.
+     */
+    cors?: inputs.FileHostingCorsArgs;
     /**
      * The file hosting domain.
      */
-    domain: pulumi.Input<string>;
+    domain: string;
+    /**
+     * The CloudFront Functions and Lambda@Edge functions of the default cache behavior. They also run on the upload behavior of the signed-upload mode.
+     */
+    edgeHandlers?: inputs.FileHostingEdgeHandlersArgs;
+    /**
+     * The bucket CloudFront fails over to when the bucket returns an error. If not provided, requests are only served from the bucket.
+     */
+    failover?: inputs.FileHostingFailoverArgs;
+    /**
+     * The countries requests are allowed from or blocked from. If not provided, requests from all countries are served.
+     */
+    geoRestriction?: inputs.FileHostingGeoRestrictionArgs;
+    /**
+     * The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+     */
+    hostedZoneId?: pulumi.Input<string>;
+    /**
+     * Whether the distribution is reachable over IPv6. If true, AAAA records are created next to the A records. Defaults to true.
+     */
+    ipv6Enabled?: boolean;
+    /**
+     * The rotation of the keys trusted to sign URLs and cookies. If not provided, a single key is used.
+     */
+    keyRotation?: inputs.FileHostingKeyRotationArgs;
+    /**
+     * The access logging settings. If not provided, no access logs are written.
+     */
+    logging?: inputs.FileHostingLoggingArgs;
+    /**
+     * Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Defaults to true.
+     */
+    manageDns?: boolean;
+    /**
+     * Whether the ownership controls of the bucket are managed by the component. Defaults to true.
+     */
+    manageOwnershipControls?: boolean;
+    /**
+     * Whether the public access block of the bucket is managed by the component. Defaults to true.
+     */
+    managePublicAccessBlock?: boolean;
+    /**
+     * Whether managed IAM policies are created for the signer, bucket reader and bucket writer roles. The policy documents are returned either way. Defaults to false.
+     */
+    managedPolicies?: boolean;
+    /**
+     * The event notifications of the bucket, e.g. to post-process uploaded files. If not provided, no notifications are configured.
+     */
+    notifications?: inputs.FileHostingNotificationsArgs;
+    /**
+     * The settings of the origin request policy to create. Conflicts with originRequestPolicyId.
+     */
+    originRequestPolicy?: inputs.FileHostingOriginRequestPolicyArgs;
+    /**
+     * The ID of an existing origin request policy to use instead of creating one.
+     */
+    originRequestPolicyId?: pulumi.Input<string>;
+    /**
+     * The region of the Origin Shield requests to the buckets go through. If not provided, Origin Shield is disabled.
+     */
+    originShieldRegion?: pulumi.Input<string>;
+    /**
+     * The price class of the distribution: PriceClass_All, PriceClass_200 or PriceClass_100. Defaults to PriceClass_All.
+     */
+    priceClass?: string;
+    /**
+     * The security headers of the response headers policy.
+     */
+    securityHeaders?: inputs.FileHostingSecurityHeadersArgs;
+    /**
+     * Which writes CloudFront passes to the bucket: full allows uploads through the default behavior, signed-upload only through the upload path pattern with its own key group and read-only none at all. Defaults to full.
+     */
+    uploadMode?: string;
+    /**
+     * The path pattern uploads are accepted under in signed-upload mode. Defaults to uploads/*.
+     */
+    uploadPathPattern?: string;
+    /**
+     * The settings of a WAF web ACL to create in us-east-1 and attach to the distribution. Conflicts with webAclArn.
+     */
+    webAcl?: inputs.WebAclArgs;
+    /**
+     * The ARN of an existing WAF web ACL to attach to the distribution. Conflicts with webAcl.
+     */
+    webAclArn?: pulumi.Input<string>;
+}
+
+export namespace FileHosting {
+    /**
+     * The set of arguments for the FileHosting.invalidate method.
+     */
+    export interface InvalidateArgs {
+        /**
+         * The paths to invalidate, relative to the file hosting URL. Paths start with / and may end with a * wildcard.
+         */
+        paths: pulumi.Input<pulumi.Input<string>[]>;
+    }
+
+    /**
+     * The results of the FileHosting.invalidate method.
+     */
+    export interface InvalidateResult {
+        /**
+         * The ID of the invalidation. Empty in previews.
+         */
+        readonly invalidationId: string;
+    }
+
+    /**
+     * The set of arguments for the FileHosting.signCookies method.
+     */
+    export interface SignCookiesArgs {
+        /**
+         * The time the signature expires at, in RFC 3339 format.
+         */
+        expiresAt: pulumi.Input<string>;
+        /**
+         * The IP address or CIDR range access is restricted to. Requires a custom policy.
+         */
+        ipAddress?: pulumi.Input<string>;
+        /**
+         * The path of the file, relative to the file hosting URL. It is the unescaped object key, which is escaped for the URL. May contain * wildcards.
+         */
+        path: pulumi.Input<string>;
+        /**
+         * The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+         */
+        startsAt?: pulumi.Input<string>;
+        /**
+         * Whether to sign with the upload key. Requires the signed-upload upload mode.
+         */
+        upload?: boolean;
+    }
+
+    /**
+     * The results of the FileHosting.signCookies method.
+     */
+    export interface SignCookiesResult {
+        /**
+         * The signed cookies by name.
+         */
+        readonly cookies: {[key: string]: string};
+    }
+
+    /**
+     * The set of arguments for the FileHosting.signUrl method.
+     */
+    export interface SignUrlArgs {
+        /**
+         * The time the signature expires at, in RFC 3339 format.
+         */
+        expiresAt: pulumi.Input<string>;
+        /**
+         * The IP address or CIDR range access is restricted to. Requires a custom policy.
+         */
+        ipAddress?: pulumi.Input<string>;
+        /**
+         * The path of the file, relative to the file hosting URL. It is the unescaped object key, which is escaped for the URL.
+         */
+        path: pulumi.Input<string>;
+        /**
+         * The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+         */
+        startsAt?: pulumi.Input<string>;
+        /**
+         * Whether to sign with the upload key. Requires the signed-upload upload mode.
+         */
+        upload?: boolean;
+    }
+
+    /**
+     * The results of the FileHosting.signUrl method.
+     */
+    export interface SignUrlResult {
+        /**
+         * The signed URL.
+         */
+        readonly url: string;
+    }
+
 }
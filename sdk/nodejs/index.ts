@@ -5,28 +5,47 @@ import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
 // Export members:
-export { FileHostingArgs } from "./fileHosting";
-export type FileHosting = import("./fileHosting").FileHosting;
-export const FileHosting: typeof import("./fileHosting").FileHosting = null as any;
-utilities.lazyLoad(exports, ["FileHosting"], () => require("./fileHosting"));
+export * from "./fileHosting";
+import { FileHosting } from "./fileHosting";
+
+export { MailUserArgs } from "./mailUser";
+export type MailUser = import("./mailUser").MailUser;
+export const MailUser: typeof import("./mailUser").MailUser = null as any;
+utilities.lazyLoad(exports, ["MailUser"], () => require("./mailUser"));
 
 export { ProviderArgs } from "./provider";
 export type Provider = import("./provider").Provider;
 export const Provider: typeof import("./provider").Provider = null as any;
 utilities.lazyLoad(exports, ["Provider"], () => require("./provider"));
 
+export { S3GraphStoreArgs } from "./s3graphStore";
+export type S3GraphStore = import("./s3graphStore").S3GraphStore;
+export const S3GraphStore: typeof import("./s3graphStore").S3GraphStore = null as any;
+utilities.lazyLoad(exports, ["S3GraphStore"], () => require("./s3graphStore"));
+
 export { StaticPageArgs } from "./staticPage";
 export type StaticPage = import("./staticPage").StaticPage;
 export const StaticPage: typeof import("./staticPage").StaticPage = null as any;
 utilities.lazyLoad(exports, ["StaticPage"], () => require("./staticPage"));
 
 
+// Export sub-modules:
+import * as types from "./types";
+
+export {
+    types,
+};
+
 const _module = {
     version: utilities.getVersion(),
     construct: (name: string, type: string, urn: string): pulumi.Resource => {
         switch (type) {
             case "gotiac:index:FileHosting":
                 return new FileHosting(name, <any>undefined, { urn })
+            case "gotiac:index:MailUser":
+                return new MailUser(name, <any>undefined, { urn })
+            case "gotiac:index:S3GraphStore":
+                return new S3GraphStore(name, <any>undefined, { urn })
             case "gotiac:index:StaticPage":
                 return new StaticPage(name, <any>undefined, { urn })
             default:
@@ -0,0 +1,144 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+export class MailUser extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'gotiac:index:MailUser';
+
+    /**
+     * Returns true if the given object is an instance of MailUser.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is MailUser {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === MailUser.__pulumiType;
+    }
+
+    /**
+     * The SES verification and DKIM records to create when DNS is not managed by the component.
+     */
+    public /*out*/ readonly dnsRecords!: pulumi.Output<outputs.DnsRecord[]>;
+    /**
+     * The ARN of the SES identity of the domain.
+     */
+    public readonly domainIdentityArn!: pulumi.Output<string>;
+    /**
+     * The email address of the mailbox.
+     */
+    public /*out*/ readonly emailAddress!: pulumi.Output<string>;
+    /**
+     * The sender address including the display name.
+     */
+    public /*out*/ readonly from!: pulumi.Output<string>;
+    /**
+     * The SES SMTP endpoint.
+     */
+    public /*out*/ readonly smtpEndpoint!: pulumi.Output<string>;
+    /**
+     * The parameter name for the SMTP password.
+     */
+    public /*out*/ readonly smtpPasswordParameterName!: pulumi.Output<string>;
+    /**
+     * The parameter name for the SMTP username.
+     */
+    public /*out*/ readonly smtpUsernameParameterName!: pulumi.Output<string>;
+
+    /**
+     * Create a MailUser resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: MailUserArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.domain === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'domain'");
+            }
+            if ((!args || args.emailPrefix === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'emailPrefix'");
+            }
+            resourceInputs["displayName"] = args ? args.displayName : undefined;
+            resourceInputs["domain"] = args ? args.domain : undefined;
+            resourceInputs["domainIdentityArn"] = args ? args.domainIdentityArn : undefined;
+            resourceInputs["emailPrefix"] = args ? args.emailPrefix : undefined;
+            resourceInputs["enabled"] = args ? args.enabled : undefined;
+            resourceInputs["hostedZoneId"] = args ? args.hostedZoneId : undefined;
+            resourceInputs["manageDns"] = args ? args.manageDns : undefined;
+            resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["passwordSeed"] = args ? args.passwordSeed : undefined;
+            resourceInputs["region"] = args ? args.region : undefined;
+            resourceInputs["dnsRecords"] = undefined /*out*/;
+            resourceInputs["emailAddress"] = undefined /*out*/;
+            resourceInputs["from"] = undefined /*out*/;
+            resourceInputs["smtpEndpoint"] = undefined /*out*/;
+            resourceInputs["smtpPasswordParameterName"] = undefined /*out*/;
+            resourceInputs["smtpUsernameParameterName"] = undefined /*out*/;
+        } else {
+            resourceInputs["dnsRecords"] = undefined /*out*/;
+            resourceInputs["domainIdentityArn"] = undefined /*out*/;
+            resourceInputs["emailAddress"] = undefined /*out*/;
+            resourceInputs["from"] = undefined /*out*/;
+            resourceInputs["smtpEndpoint"] = undefined /*out*/;
+            resourceInputs["smtpPasswordParameterName"] = undefined /*out*/;
+            resourceInputs["smtpUsernameParameterName"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(MailUser.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a MailUser resource.
+ */
+export interface MailUserArgs {
+    /**
+     * The display name used in the sender address.
+     */
+    displayName?: pulumi.Input<string>;
+    /**
+     * The mail domain.
+     */
+    domain: pulumi.Input<string>;
+    /**
+     * The ARN of the SES identity of the domain, e.g. the domainIdentityArn output of another MailUser of the domain. If provided, the identity and its DNS records are not created, so that mailboxes of one domain do not compete for them.
+     */
+    domainIdentityArn?: pulumi.Input<string>;
+    /**
+     * The local part of the email address.
+     */
+    emailPrefix: pulumi.Input<string>;
+    /**
+     * Whether the SMTP credentials are active. Defaults to true.
+     */
+    enabled?: pulumi.Input<boolean>;
+    /**
+     * The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+     */
+    hostedZoneId?: pulumi.Input<string>;
+    /**
+     * Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Mail can be sent once SES has verified the domain through them. Defaults to true.
+     */
+    manageDns?: boolean;
+    /**
+     * The name of the mailbox.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * A seed that only triggers the rotation of the SMTP credentials, changing it replaces the access key. The credentials are generated by IAM, not derived from the seed, so it is not a secret.
+     */
+    passwordSeed?: string;
+    /**
+     * The region of the SES identity and SMTP endpoint. Defaults to the region of the stack.
+     */
+    region?: pulumi.Input<string>;
+}
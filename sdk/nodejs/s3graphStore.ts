@@ -0,0 +1,88 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+export class S3GraphStore extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'gotiac:index:S3GraphStore';
+
+    /**
+     * Returns true if the given object is an instance of S3GraphStore.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is S3GraphStore {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === S3GraphStore.__pulumiType;
+    }
+
+    /**
+     * The ARN of the graph store bucket.
+     */
+    public /*out*/ readonly bucketArn!: pulumi.Output<string>;
+    /**
+     * The name of the graph store bucket.
+     */
+    public readonly bucketName!: pulumi.Output<string>;
+    /**
+     * The ARN of the role with read access to the nodes, edges and props prefixes.
+     */
+    public /*out*/ readonly readerRoleArn!: pulumi.Output<string>;
+    /**
+     * The ARN of the role with read and write access to the nodes, edges and props prefixes.
+     */
+    public /*out*/ readonly writerRoleArn!: pulumi.Output<string>;
+
+    /**
+     * Create a S3GraphStore resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: S3GraphStoreArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            resourceInputs["bucketName"] = args ? args.bucketName : undefined;
+            resourceInputs["forceDestroy"] = args ? args.forceDestroy : undefined;
+            resourceInputs["kmsKeyArn"] = args ? args.kmsKeyArn : undefined;
+            resourceInputs["noncurrentVersionExpirationDays"] = args ? args.noncurrentVersionExpirationDays : undefined;
+            resourceInputs["bucketArn"] = undefined /*out*/;
+            resourceInputs["readerRoleArn"] = undefined /*out*/;
+            resourceInputs["writerRoleArn"] = undefined /*out*/;
+        } else {
+            resourceInputs["bucketArn"] = undefined /*out*/;
+            resourceInputs["bucketName"] = undefined /*out*/;
+            resourceInputs["readerRoleArn"] = undefined /*out*/;
+            resourceInputs["writerRoleArn"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(S3GraphStore.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a S3GraphStore resource.
+ */
+export interface S3GraphStoreArgs {
+    /**
+     * The name of the bucket to create. If not provided, a unique name will be generated.
+     */
+    bucketName?: pulumi.Input<string>;
+    /**
+     * Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+     */
+    forceDestroy?: pulumi.Input<boolean>;
+    /**
+     * The ARN of a KMS key used to encrypt the graph. If not provided, objects are encrypted with S3 managed keys.
+     */
+    kmsKeyArn?: pulumi.Input<string>;
+    /**
+     * The number of days noncurrent object versions are kept. Defaults to 30.
+     */
+    noncurrentVersionExpirationDays?: pulumi.Input<number>;
+}
@@ -0,0 +1,408 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+
+/**
+ * The settings of the bucket a FileHosting creates when no bucket name is given.
+ */
+export interface FileHostingBucketArgs {
+    /**
+     * The number of days after which incomplete multipart uploads are aborted. Defaults to 7, 0 disables the rule.
+     */
+    abortIncompleteMultipartUploadDays?: number;
+    /**
+     * The server side encryption of the bucket: SSE-S3 or SSE-KMS. Defaults to SSE-S3.
+     */
+    encryption?: string;
+    /**
+     * Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+     */
+    forceDestroy?: boolean;
+    /**
+     * The ARN of the KMS key used with SSE-KMS. Its key policy has to allow CloudFront to use it. If not provided, a key is generated.
+     */
+    kmsKeyArn?: pulumi.Input<string>;
+    /**
+     * The lifecycle rules of the bucket.
+     */
+    lifecycleRules?: pulumi.Input<inputs.FileHostingLifecycleRuleArgs>[];
+    /**
+     * Whether the bucket is protected from being deleted by Pulumi. Defaults to false.
+     */
+    protect?: boolean;
+    /**
+     * Whether the bucket is kept in AWS when it is deleted from the stack. Defaults to false.
+     */
+    retainOnDelete?: boolean;
+    /**
+     * Whether versioning is enabled. Defaults to false.
+     */
+    versioning?: boolean;
+}
+
+/**
+ * A cache behavior of a FileHosting for a path pattern.
+ */
+export interface FileHostingCacheBehaviorArgs {
+    /**
+     * The HTTP methods CloudFront processes and forwards to the bucket. Defaults to GET, HEAD and OPTIONS. With failover, a behavior allowing other methods targets the bucket directly.
+     */
+    allowedMethods?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The ID of the cache policy of the behavior. Defaults to the cache policy of the component.
+     */
+    cachePolicyId?: pulumi.Input<string>;
+    /**
+     * The CloudFront Functions and Lambda@Edge functions of the behavior.
+     */
+    edgeHandlers?: inputs.FileHostingEdgeHandlersArgs;
+    /**
+     * The path pattern the behavior applies to, e.g. public/*.
+     */
+    pathPattern: pulumi.Input<string>;
+    /**
+     * Whether requests require signed URLs or cookies. Defaults to true.
+     */
+    signed?: boolean;
+}
+
+/**
+ * The settings of the cache policy a FileHosting creates.
+ */
+export interface FileHostingCachePolicyArgs {
+    /**
+     * Which cookies are part of the cache key: none, whitelist, allExcept or all. Defaults to none.
+     */
+    cookieBehavior?: pulumi.Input<string>;
+    /**
+     * The cookies the cookie behavior applies to.
+     */
+    cookies?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The default time objects stay in the cache, in seconds. Defaults to 86400.
+     */
+    defaultTtl?: pulumi.Input<number>;
+    /**
+     * Whether brotli compressed objects are cached and served. Defaults to false.
+     */
+    enableAcceptEncodingBrotli?: pulumi.Input<boolean>;
+    /**
+     * Whether gzip compressed objects are cached and served. Defaults to false.
+     */
+    enableAcceptEncodingGzip?: pulumi.Input<boolean>;
+    /**
+     * Which headers are part of the cache key: none or whitelist. Defaults to none.
+     */
+    headerBehavior?: pulumi.Input<string>;
+    /**
+     * The headers the header behavior applies to.
+     */
+    headers?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The maximum time objects stay in the cache, in seconds. Defaults to 31536000.
+     */
+    maxTtl?: pulumi.Input<number>;
+    /**
+     * The minimum time objects stay in the cache, in seconds. Defaults to 1.
+     */
+    minTtl?: pulumi.Input<number>;
+    /**
+     * Which query strings are part of the cache key: none, whitelist, allExcept or all. Defaults to whitelist.
+     */
+    queryStringBehavior?: pulumi.Input<string>;
+    /**
+     * The query strings the query string behavior applies to. Defaults to etag.
+     */
+    queryStrings?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * The CORS settings of the response headers policy of a FileHosting.
+ */
+export interface FileHostingCorsArgs {
+    /**
+     * Whether cross-origin requests may include credentials. Requires explicit allowed origins. Defaults to false.
+     */
+    allowCredentials?: boolean;
+    /**
+     * The headers allowed in cross-origin requests. Defaults to all headers.
+     */
+    allowedHeaders?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The HTTP methods allowed in cross-origin requests. Defaults to ALL.
+     */
+    allowedMethods?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The origins allowed to access the files. Defaults to all origins.
+     */
+    allowedOrigins?: pulumi.Input<string>[];
+    /**
+     * The headers exposed to cross-origin responses. Defaults to ETag, which multipart uploads need.
+     */
+    exposeHeaders?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The time browsers may cache preflight responses, in seconds. Defaults to 600.
+     */
+    maxAgeSeconds?: pulumi.Input<number>;
+}
+
+/**
+ * The edge handlers of a cache behavior of a FileHosting. Each event type takes either a CloudFront Function or a Lambda@Edge function.
+ */
+export interface FileHostingEdgeHandlersArgs {
+    /**
+     * The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin requests.
+     */
+    originRequestLambdaArn?: pulumi.Input<string>;
+    /**
+     * The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin responses, e.g. to set the Content-Disposition header before the response is cached.
+     */
+    originResponseLambdaArn?: pulumi.Input<string>;
+    /**
+     * The code of a CloudFront Function run on viewer requests, e.g. to rewrite paths or check tokens. The function is created and published with the cloudfront-js-2.0 runtime.
+     */
+    viewerRequestFunction?: pulumi.Input<string>;
+    /**
+     * The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer requests.
+     */
+    viewerRequestLambdaArn?: pulumi.Input<string>;
+    /**
+     * The code of a CloudFront Function run on viewer responses, e.g. to set headers.
+     */
+    viewerResponseFunction?: pulumi.Input<string>;
+    /**
+     * The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer responses.
+     */
+    viewerResponseLambdaArn?: pulumi.Input<string>;
+}
+
+/**
+ * The bucket a FileHosting fails over to.
+ */
+export interface FileHostingFailoverArgs {
+    /**
+     * The name of an existing bucket to fail over to. If not provided, a bucket is created.
+     */
+    bucketName?: pulumi.Input<string>;
+    /**
+     * The region of the failover bucket. Defaults to the region of the stack.
+     */
+    bucketRegion?: pulumi.Input<string>;
+    /**
+     * Whether the objects of the bucket are replicated to the failover bucket. Both buckets need versioning enabled. Defaults to false.
+     */
+    replication?: boolean;
+    /**
+     * The status codes of the bucket on which CloudFront retries the request with the failover bucket. Defaults to 500, 502, 503, 504, 403 and 404.
+     */
+    statusCodes?: pulumi.Input<number>[];
+}
+
+/**
+ * The geo restriction of a FileHosting.
+ */
+export interface FileHostingGeoRestrictionArgs {
+    /**
+     * The ISO 3166-1 alpha-2 codes of the countries the restriction type applies to.
+     */
+    locations?: pulumi.Input<string>[];
+    /**
+     * How the locations are applied: whitelist only allows requests from them, blacklist blocks them and none disables the restriction.
+     */
+    restrictionType: string;
+}
+
+/**
+ * The key rotation settings of a FileHosting.
+ */
+export interface FileHostingKeyRotationArgs {
+    /**
+     * The generation of the key used for signing. Incrementing it creates a new key and rotates signing to it. Defaults to 1.
+     */
+    activeGeneration?: number;
+    /**
+     * The number of key generations kept in the trusted key group, including the active one. Previous keys stay trusted so that outstanding signatures remain valid. Defaults to 2.
+     */
+    generations?: number;
+}
+
+/**
+ * A lifecycle rule of the bucket of a FileHosting.
+ */
+export interface FileHostingLifecycleRuleArgs {
+    /**
+     * The number of days after which objects expire.
+     */
+    expirationDays?: pulumi.Input<number>;
+    /**
+     * The number of days after which noncurrent object versions expire.
+     */
+    noncurrentVersionExpirationDays?: pulumi.Input<number>;
+    /**
+     * The key prefix the rule applies to. If not provided, the rule applies to all objects.
+     */
+    prefix?: pulumi.Input<string>;
+    /**
+     * The transitions of objects to other storage classes.
+     */
+    transitions?: pulumi.Input<inputs.FileHostingLifecycleTransitionArgs>[];
+}
+
+/**
+ * A transition of objects to another storage class.
+ */
+export interface FileHostingLifecycleTransitionArgs {
+    /**
+     * The number of days after which objects are transitioned.
+     */
+    days: pulumi.Input<number>;
+    /**
+     * The storage class objects are transitioned to, e.g. STANDARD_IA or GLACIER_IR.
+     */
+    storageClass: pulumi.Input<string>;
+}
+
+/**
+ * The access logging settings of a FileHosting.
+ */
+export interface FileHostingLoggingArgs {
+    /**
+     * The name of an existing bucket to write the logs to. It needs ACLs enabled for CloudFront standard logs and a bucket policy allowing S3 server access logs. If not provided, a log bucket is created.
+     */
+    bucketName?: pulumi.Input<string>;
+    /**
+     * The key prefix of the CloudFront standard logs. Defaults to cloudfront/.
+     */
+    cloudFrontPrefix?: pulumi.Input<string>;
+    /**
+     * Whether CloudFront logs include cookies. Defaults to false.
+     */
+    includeCookies?: pulumi.Input<boolean>;
+    /**
+     * The number of days logs are kept in a created log bucket. Defaults to 90.
+     */
+    retentionDays?: pulumi.Input<number>;
+    /**
+     * The key prefix of the S3 server access logs. Defaults to s3/.
+     */
+    s3Prefix?: pulumi.Input<string>;
+}
+
+/**
+ * The event notifications of the bucket of a FileHosting. The notification configuration replaces any existing configuration of the bucket. The events go to a single destination and optionally to EventBridge.
+ */
+export interface FileHostingNotificationsArgs {
+    /**
+     * Whether all events of the bucket are sent to EventBridge. Defaults to false.
+     */
+    eventBridge?: boolean;
+    /**
+     * The S3 events notifications are sent for. Defaults to s3:ObjectCreated:*.
+     */
+    events?: pulumi.Input<string>[];
+    /**
+     * The key prefix of the objects notifications are sent for, e.g. uploads/.
+     */
+    filterPrefix?: pulumi.Input<string>;
+    /**
+     * The key suffix of the objects notifications are sent for, e.g. .jpg.
+     */
+    filterSuffix?: pulumi.Input<string>;
+    /**
+     * The ARN of a Lambda function in the region of the bucket to invoke. The permission for S3 to invoke it is created. Conflicts with the other destinations.
+     */
+    lambdaFunctionArn?: pulumi.Input<string>;
+    /**
+     * Whether to create an SQS queue the notifications are sent to. Conflicts with the other destinations.
+     */
+    queue?: boolean;
+    /**
+     * The ARN of an existing SQS queue to send the notifications to. Its policy has to allow S3 to send messages. Conflicts with the other destinations.
+     */
+    queueArn?: pulumi.Input<string>;
+    /**
+     * Whether to create an SNS topic the notifications are published to. Conflicts with the other destinations.
+     */
+    topic?: boolean;
+    /**
+     * The ARN of an existing SNS topic to publish the notifications to. Its policy has to allow S3 to publish. Conflicts with the other destinations.
+     */
+    topicArn?: pulumi.Input<string>;
+}
+
+/**
+ * The settings of the origin request policy a FileHosting creates.
+ */
+export interface FileHostingOriginRequestPolicyArgs {
+    /**
+     * Which cookies are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to none.
+     */
+    cookieBehavior?: pulumi.Input<string>;
+    /**
+     * The cookies the cookie behavior applies to.
+     */
+    cookies?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Which headers are forwarded to the bucket: none, whitelist, allViewer, allViewerAndWhitelistCloudFront or allExcept. Defaults to whitelist.
+     */
+    headerBehavior?: pulumi.Input<string>;
+    /**
+     * The headers the header behavior applies to. Defaults to Content-Type.
+     */
+    headers?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Which query strings are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to whitelist.
+     */
+    queryStringBehavior?: pulumi.Input<string>;
+    /**
+     * The query strings the query string behavior applies to. Defaults to partNumber and uploadId, which multipart uploads need.
+     */
+    queryStrings?: pulumi.Input<pulumi.Input<string>[]>;
+}
+
+/**
+ * The security headers of the response headers policy of a FileHosting.
+ */
+export interface FileHostingSecurityHeadersArgs {
+    /**
+     * The Content-Security-Policy header. Defaults to default-src 'none', so hosted files cannot load or run anything when opened directly.
+     */
+    contentSecurityPolicy?: pulumi.Input<string>;
+    /**
+     * Whether the Strict-Transport-Security header includes subdomains. Defaults to true.
+     */
+    strictTransportSecurityIncludeSubdomains?: pulumi.Input<boolean>;
+    /**
+     * The max age of the Strict-Transport-Security header, in seconds. Defaults to 31536000.
+     */
+    strictTransportSecurityMaxAge?: pulumi.Input<number>;
+    /**
+     * Whether the Strict-Transport-Security header allows preloading. Defaults to false.
+     */
+    strictTransportSecurityPreload?: pulumi.Input<boolean>;
+}
+
+/**
+ * The settings of a WAF web ACL created for a CloudFront distribution.
+ */
+export interface WebAclArgs {
+    /**
+     * The IP addresses and CIDR ranges that are allowed. If provided, all other requests are blocked.
+     */
+    allowedIpAddresses?: pulumi.Input<string>[];
+    /**
+     * The IP addresses and CIDR ranges that are blocked.
+     */
+    blockedIpAddresses?: pulumi.Input<string>[];
+    /**
+     * The names of the AWS managed rule groups to apply. Defaults to AWSManagedRulesAmazonIpReputationList and AWSManagedRulesKnownBadInputsRuleSet.
+     */
+    managedRuleGroups?: pulumi.Input<string>[];
+    /**
+     * The maximum number of requests a single IP address may make in 5 minutes before it is blocked. Defaults to 2000, 0 disables rate limiting.
+     */
+    rateLimit?: number;
+}
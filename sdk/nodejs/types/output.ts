@@ -0,0 +1,25 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+
+/**
+ * A DNS record to create in an external DNS provider.
+ */
+export interface DnsRecord {
+    /**
+     * The name of the record.
+     */
+    name: string;
+    /**
+     * The type of the record.
+     */
+    type: string;
+    /**
+     * The value of the record.
+     */
+    value: string;
+}
+
@@ -6,8 +6,12 @@ from . import _utilities
 import typing
 # Export this package's modules as members:
 from .file_hosting import *
+from .mail_user import *
 from .provider import *
+from .s3_graph_store import *
 from .static_page import *
+from ._inputs import *
+from . import outputs
 _utilities.register(
     resource_modules="""
 [
@@ -17,6 +21,8 @@ _utilities.register(
   "fqn": "pulumi_gotiac",
   "classes": {
    "gotiac:index:FileHosting": "FileHosting",
+   "gotiac:index:MailUser": "MailUser",
+   "gotiac:index:S3GraphStore": "S3GraphStore",
    "gotiac:index:StaticPage": "StaticPage"
   }
  }
@@ -0,0 +1,1393 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = [
+    'FileHostingBucketArgs',
+    'FileHostingCacheBehaviorArgs',
+    'FileHostingCachePolicyArgs',
+    'FileHostingCorsArgs',
+    'FileHostingEdgeHandlersArgs',
+    'FileHostingFailoverArgs',
+    'FileHostingGeoRestrictionArgs',
+    'FileHostingKeyRotationArgs',
+    'FileHostingLifecycleRuleArgs',
+    'FileHostingLifecycleTransitionArgs',
+    'FileHostingLoggingArgs',
+    'FileHostingNotificationsArgs',
+    'FileHostingOriginRequestPolicyArgs',
+    'FileHostingSecurityHeadersArgs',
+    'WebAclArgs',
+]
+
+@pulumi.input_type
+class FileHostingBucketArgs:
+    def __init__(__self__, *,
+                 abort_incomplete_multipart_upload_days: Optional[int] = None,
+                 encryption: Optional[str] = None,
+                 force_destroy: Optional[bool] = None,
+                 kms_key_arn: Optional[pulumi.Input[str]] = None,
+                 lifecycle_rules: Optional[Sequence[pulumi.Input['FileHostingLifecycleRuleArgs']]] = None,
+                 protect: Optional[bool] = None,
+                 retain_on_delete: Optional[bool] = None,
+                 versioning: Optional[bool] = None):
+        """
+        The settings of the bucket a FileHosting creates when no bucket name is given.
+        :param int abort_incomplete_multipart_upload_days: The number of days after which incomplete multipart uploads are aborted. Defaults to 7, 0 disables the rule.
+        :param str encryption: The server side encryption of the bucket: SSE-S3 or SSE-KMS. Defaults to SSE-S3.
+        :param bool force_destroy: Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+        :param pulumi.Input[str] kms_key_arn: The ARN of the KMS key used with SSE-KMS. Its key policy has to allow CloudFront to use it. If not provided, a key is generated.
+        :param Sequence[pulumi.Input['FileHostingLifecycleRuleArgs']] lifecycle_rules: The lifecycle rules of the bucket.
+        :param bool protect: Whether the bucket is protected from being deleted by Pulumi. Defaults to false.
+        :param bool retain_on_delete: Whether the bucket is kept in AWS when it is deleted from the stack. Defaults to false.
+        :param bool versioning: Whether versioning is enabled. Defaults to false.
+        """
+        if abort_incomplete_multipart_upload_days is not None:
+            pulumi.set(__self__, "abort_incomplete_multipart_upload_days", abort_incomplete_multipart_upload_days)
+        if encryption is not None:
+            pulumi.set(__self__, "encryption", encryption)
+        if force_destroy is not None:
+            pulumi.set(__self__, "force_destroy", force_destroy)
+        if kms_key_arn is not None:
+            pulumi.set(__self__, "kms_key_arn", kms_key_arn)
+        if lifecycle_rules is not None:
+            pulumi.set(__self__, "lifecycle_rules", lifecycle_rules)
+        if protect is not None:
+            pulumi.set(__self__, "protect", protect)
+        if retain_on_delete is not None:
+            pulumi.set(__self__, "retain_on_delete", retain_on_delete)
+        if versioning is not None:
+            pulumi.set(__self__, "versioning", versioning)
+
+    @property
+    @pulumi.getter(name="abortIncompleteMultipartUploadDays")
+    def abort_incomplete_multipart_upload_days(self) -> Optional[int]:
+        """
+        The number of days after which incomplete multipart uploads are aborted. Defaults to 7, 0 disables the rule.
+        """
+        return pulumi.get(self, "abort_incomplete_multipart_upload_days")
+
+    @abort_incomplete_multipart_upload_days.setter
+    def abort_incomplete_multipart_upload_days(self, value: Optional[int]):
+        pulumi.set(self, "abort_incomplete_multipart_upload_days", value)
+
+    @property
+    @pulumi.getter
+    def encryption(self) -> Optional[str]:
+        """
+        The server side encryption of the bucket: SSE-S3 or SSE-KMS. Defaults to SSE-S3.
+        """
+        return pulumi.get(self, "encryption")
+
+    @encryption.setter
+    def encryption(self, value: Optional[str]):
+        pulumi.set(self, "encryption", value)
+
+    @property
+    @pulumi.getter(name="forceDestroy")
+    def force_destroy(self) -> Optional[bool]:
+        """
+        Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+        """
+        return pulumi.get(self, "force_destroy")
+
+    @force_destroy.setter
+    def force_destroy(self, value: Optional[bool]):
+        pulumi.set(self, "force_destroy", value)
+
+    @property
+    @pulumi.getter(name="kmsKeyArn")
+    def kms_key_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of the KMS key used with SSE-KMS. Its key policy has to allow CloudFront to use it. If not provided, a key is generated.
+        """
+        return pulumi.get(self, "kms_key_arn")
+
+    @kms_key_arn.setter
+    def kms_key_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "kms_key_arn", value)
+
+    @property
+    @pulumi.getter(name="lifecycleRules")
+    def lifecycle_rules(self) -> Optional[Sequence[pulumi.Input['FileHostingLifecycleRuleArgs']]]:
+        """
+        The lifecycle rules of the bucket.
+        """
+        return pulumi.get(self, "lifecycle_rules")
+
+    @lifecycle_rules.setter
+    def lifecycle_rules(self, value: Optional[Sequence[pulumi.Input['FileHostingLifecycleRuleArgs']]]):
+        pulumi.set(self, "lifecycle_rules", value)
+
+    @property
+    @pulumi.getter
+    def protect(self) -> Optional[bool]:
+        """
+        Whether the bucket is protected from being deleted by Pulumi. Defaults to false.
+        """
+        return pulumi.get(self, "protect")
+
+    @protect.setter
+    def protect(self, value: Optional[bool]):
+        pulumi.set(self, "protect", value)
+
+    @property
+    @pulumi.getter(name="retainOnDelete")
+    def retain_on_delete(self) -> Optional[bool]:
+        """
+        Whether the bucket is kept in AWS when it is deleted from the stack. Defaults to false.
+        """
+        return pulumi.get(self, "retain_on_delete")
+
+    @retain_on_delete.setter
+    def retain_on_delete(self, value: Optional[bool]):
+        pulumi.set(self, "retain_on_delete", value)
+
+    @property
+    @pulumi.getter
+    def versioning(self) -> Optional[bool]:
+        """
+        Whether versioning is enabled. Defaults to false.
+        """
+        return pulumi.get(self, "versioning")
+
+    @versioning.setter
+    def versioning(self, value: Optional[bool]):
+        pulumi.set(self, "versioning", value)
+
+
+@pulumi.input_type
+class FileHostingCacheBehaviorArgs:
+    def __init__(__self__, *,
+                 path_pattern: pulumi.Input[str],
+                 allowed_methods: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 cache_policy_id: Optional[pulumi.Input[str]] = None,
+                 edge_handlers: Optional['FileHostingEdgeHandlersArgs'] = None,
+                 signed: Optional[bool] = None):
+        """
+        A cache behavior of a FileHosting for a path pattern.
+        :param pulumi.Input[str] path_pattern: The path pattern the behavior applies to, e.g. public/*.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] allowed_methods: The HTTP methods CloudFront processes and forwards to the bucket. Defaults to GET, HEAD and OPTIONS. With failover, a behavior allowing other methods targets the bucket directly.
+        :param pulumi.Input[str] cache_policy_id: The ID of the cache policy of the behavior. Defaults to the cache policy of the component.
+        :param 'FileHostingEdgeHandlersArgs' edge_handlers: The CloudFront Functions and Lambda@Edge functions of the behavior.
+        :param bool signed: Whether requests require signed URLs or cookies. Defaults to true.
+        """
+        pulumi.set(__self__, "path_pattern", path_pattern)
+        if allowed_methods is not None:
+            pulumi.set(__self__, "allowed_methods", allowed_methods)
+        if cache_policy_id is not None:
+            pulumi.set(__self__, "cache_policy_id", cache_policy_id)
+        if edge_handlers is not None:
+            pulumi.set(__self__, "edge_handlers", edge_handlers)
+        if signed is not None:
+            pulumi.set(__self__, "signed", signed)
+
+    @property
+    @pulumi.getter(name="pathPattern")
+    def path_pattern(self) -> pulumi.Input[str]:
+        """
+        The path pattern the behavior applies to, e.g. public/*.
+        """
+        return pulumi.get(self, "path_pattern")
+
+    @path_pattern.setter
+    def path_pattern(self, value: pulumi.Input[str]):
+        pulumi.set(self, "path_pattern", value)
+
+    @property
+    @pulumi.getter(name="allowedMethods")
+    def allowed_methods(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The HTTP methods CloudFront processes and forwards to the bucket. Defaults to GET, HEAD and OPTIONS. With failover, a behavior allowing other methods targets the bucket directly.
+        """
+        return pulumi.get(self, "allowed_methods")
+
+    @allowed_methods.setter
+    def allowed_methods(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "allowed_methods", value)
+
+    @property
+    @pulumi.getter(name="cachePolicyId")
+    def cache_policy_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ID of the cache policy of the behavior. Defaults to the cache policy of the component.
+        """
+        return pulumi.get(self, "cache_policy_id")
+
+    @cache_policy_id.setter
+    def cache_policy_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "cache_policy_id", value)
+
+    @property
+    @pulumi.getter(name="edgeHandlers")
+    def edge_handlers(self) -> Optional['FileHostingEdgeHandlersArgs']:
+        """
+        The CloudFront Functions and Lambda@Edge functions of the behavior.
+        """
+        return pulumi.get(self, "edge_handlers")
+
+    @edge_handlers.setter
+    def edge_handlers(self, value: Optional['FileHostingEdgeHandlersArgs']):
+        pulumi.set(self, "edge_handlers", value)
+
+    @property
+    @pulumi.getter
+    def signed(self) -> Optional[bool]:
+        """
+        Whether requests require signed URLs or cookies. Defaults to true.
+        """
+        return pulumi.get(self, "signed")
+
+    @signed.setter
+    def signed(self, value: Optional[bool]):
+        pulumi.set(self, "signed", value)
+
+
+@pulumi.input_type
+class FileHostingCachePolicyArgs:
+    def __init__(__self__, *,
+                 cookie_behavior: Optional[pulumi.Input[str]] = None,
+                 cookies: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 default_ttl: Optional[pulumi.Input[int]] = None,
+                 enable_accept_encoding_brotli: Optional[pulumi.Input[bool]] = None,
+                 enable_accept_encoding_gzip: Optional[pulumi.Input[bool]] = None,
+                 header_behavior: Optional[pulumi.Input[str]] = None,
+                 headers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 max_ttl: Optional[pulumi.Input[int]] = None,
+                 min_ttl: Optional[pulumi.Input[int]] = None,
+                 query_string_behavior: Optional[pulumi.Input[str]] = None,
+                 query_strings: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        The settings of the cache policy a FileHosting creates.
+        :param pulumi.Input[str] cookie_behavior: Which cookies are part of the cache key: none, whitelist, allExcept or all. Defaults to none.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] cookies: The cookies the cookie behavior applies to.
+        :param pulumi.Input[int] default_ttl: The default time objects stay in the cache, in seconds. Defaults to 86400.
+        :param pulumi.Input[bool] enable_accept_encoding_brotli: Whether brotli compressed objects are cached and served. Defaults to false.
+        :param pulumi.Input[bool] enable_accept_encoding_gzip: Whether gzip compressed objects are cached and served. Defaults to false.
+        :param pulumi.Input[str] header_behavior: Which headers are part of the cache key: none or whitelist. Defaults to none.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] headers: The headers the header behavior applies to.
+        :param pulumi.Input[int] max_ttl: The maximum time objects stay in the cache, in seconds. Defaults to 31536000.
+        :param pulumi.Input[int] min_ttl: The minimum time objects stay in the cache, in seconds. Defaults to 1.
+        :param pulumi.Input[str] query_string_behavior: Which query strings are part of the cache key: none, whitelist, allExcept or all. Defaults to whitelist.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] query_strings: The query strings the query string behavior applies to. Defaults to etag.
+        """
+        if cookie_behavior is not None:
+            pulumi.set(__self__, "cookie_behavior", cookie_behavior)
+        if cookies is not None:
+            pulumi.set(__self__, "cookies", cookies)
+        if default_ttl is not None:
+            pulumi.set(__self__, "default_ttl", default_ttl)
+        if enable_accept_encoding_brotli is not None:
+            pulumi.set(__self__, "enable_accept_encoding_brotli", enable_accept_encoding_brotli)
+        if enable_accept_encoding_gzip is not None:
+            pulumi.set(__self__, "enable_accept_encoding_gzip", enable_accept_encoding_gzip)
+        if header_behavior is not None:
+            pulumi.set(__self__, "header_behavior", header_behavior)
+        if headers is not None:
+            pulumi.set(__self__, "headers", headers)
+        if max_ttl is not None:
+            pulumi.set(__self__, "max_ttl", max_ttl)
+        if min_ttl is not None:
+            pulumi.set(__self__, "min_ttl", min_ttl)
+        if query_string_behavior is not None:
+            pulumi.set(__self__, "query_string_behavior", query_string_behavior)
+        if query_strings is not None:
+            pulumi.set(__self__, "query_strings", query_strings)
+
+    @property
+    @pulumi.getter(name="cookieBehavior")
+    def cookie_behavior(self) -> Optional[pulumi.Input[str]]:
+        """
+        Which cookies are part of the cache key: none, whitelist, allExcept or all. Defaults to none.
+        """
+        return pulumi.get(self, "cookie_behavior")
+
+    @cookie_behavior.setter
+    def cookie_behavior(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "cookie_behavior", value)
+
+    @property
+    @pulumi.getter
+    def cookies(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The cookies the cookie behavior applies to.
+        """
+        return pulumi.get(self, "cookies")
+
+    @cookies.setter
+    def cookies(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "cookies", value)
+
+    @property
+    @pulumi.getter(name="defaultTtl")
+    def default_ttl(self) -> Optional[pulumi.Input[int]]:
+        """
+        The default time objects stay in the cache, in seconds. Defaults to 86400.
+        """
+        return pulumi.get(self, "default_ttl")
+
+    @default_ttl.setter
+    def default_ttl(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "default_ttl", value)
+
+    @property
+    @pulumi.getter(name="enableAcceptEncodingBrotli")
+    def enable_accept_encoding_brotli(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether brotli compressed objects are cached and served. Defaults to false.
+        """
+        return pulumi.get(self, "enable_accept_encoding_brotli")
+
+    @enable_accept_encoding_brotli.setter
+    def enable_accept_encoding_brotli(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enable_accept_encoding_brotli", value)
+
+    @property
+    @pulumi.getter(name="enableAcceptEncodingGzip")
+    def enable_accept_encoding_gzip(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether gzip compressed objects are cached and served. Defaults to false.
+        """
+        return pulumi.get(self, "enable_accept_encoding_gzip")
+
+    @enable_accept_encoding_gzip.setter
+    def enable_accept_encoding_gzip(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enable_accept_encoding_gzip", value)
+
+    @property
+    @pulumi.getter(name="headerBehavior")
+    def header_behavior(self) -> Optional[pulumi.Input[str]]:
+        """
+        Which headers are part of the cache key: none or whitelist. Defaults to none.
+        """
+        return pulumi.get(self, "header_behavior")
+
+    @header_behavior.setter
+    def header_behavior(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "header_behavior", value)
+
+    @property
+    @pulumi.getter
+    def headers(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The headers the header behavior applies to.
+        """
+        return pulumi.get(self, "headers")
+
+    @headers.setter
+    def headers(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "headers", value)
+
+    @property
+    @pulumi.getter(name="maxTtl")
+    def max_ttl(self) -> Optional[pulumi.Input[int]]:
+        """
+        The maximum time objects stay in the cache, in seconds. Defaults to 31536000.
+        """
+        return pulumi.get(self, "max_ttl")
+
+    @max_ttl.setter
+    def max_ttl(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "max_ttl", value)
+
+    @property
+    @pulumi.getter(name="minTtl")
+    def min_ttl(self) -> Optional[pulumi.Input[int]]:
+        """
+        The minimum time objects stay in the cache, in seconds. Defaults to 1.
+        """
+        return pulumi.get(self, "min_ttl")
+
+    @min_ttl.setter
+    def min_ttl(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "min_ttl", value)
+
+    @property
+    @pulumi.getter(name="queryStringBehavior")
+    def query_string_behavior(self) -> Optional[pulumi.Input[str]]:
+        """
+        Which query strings are part of the cache key: none, whitelist, allExcept or all. Defaults to whitelist.
+        """
+        return pulumi.get(self, "query_string_behavior")
+
+    @query_string_behavior.setter
+    def query_string_behavior(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "query_string_behavior", value)
+
+    @property
+    @pulumi.getter(name="queryStrings")
+    def query_strings(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The query strings the query string behavior applies to. Defaults to etag.
+        """
+        return pulumi.get(self, "query_strings")
+
+    @query_strings.setter
+    def query_strings(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "query_strings", value)
+
+
+@pulumi.input_type
+class FileHostingCorsArgs:
+    def __init__(__self__, *,
+                 allow_credentials: Optional[bool] = None,
+                 allowed_headers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 allowed_methods: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 allowed_origins: Optional[Sequence[pulumi.Input[str]]] = None,
+                 expose_headers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 max_age_seconds: Optional[pulumi.Input[int]] = None):
+        """
+        The CORS settings of the response headers policy of a FileHosting.
+        :param bool allow_credentials: Whether cross-origin requests may include credentials. Requires explicit allowed origins. Defaults to false.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] allowed_headers: The headers allowed in cross-origin requests. Defaults to all headers.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] allowed_methods: The HTTP methods allowed in cross-origin requests. Defaults to ALL.
+        :param Sequence[pulumi.Input[str]] allowed_origins: The origins allowed to access the files. Defaults to all origins.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] expose_headers: The headers exposed to cross-origin responses. Defaults to ETag, which multipart uploads need.
+        :param pulumi.Input[int] max_age_seconds: The time browsers may cache preflight responses, in seconds. Defaults to 600.
+        """
+        if allow_credentials is not None:
+            pulumi.set(__self__, "allow_credentials", allow_credentials)
+        if allowed_headers is not None:
+            pulumi.set(__self__, "allowed_headers", allowed_headers)
+        if allowed_methods is not None:
+            pulumi.set(__self__, "allowed_methods", allowed_methods)
+        if allowed_origins is not None:
+            pulumi.set(__self__, "allowed_origins", allowed_origins)
+        if expose_headers is not None:
+            pulumi.set(__self__, "expose_headers", expose_headers)
+        if max_age_seconds is not None:
+            pulumi.set(__self__, "max_age_seconds", max_age_seconds)
+
+    @property
+    @pulumi.getter(name="allowCredentials")
+    def allow_credentials(self) -> Optional[bool]:
+        """
+        Whether cross-origin requests may include credentials. Requires explicit allowed origins. Defaults to false.
+        """
+        return pulumi.get(self, "allow_credentials")
+
+    @allow_credentials.setter
+    def allow_credentials(self, value: Optional[bool]):
+        pulumi.set(self, "allow_credentials", value)
+
+    @property
+    @pulumi.getter(name="allowedHeaders")
+    def allowed_headers(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The headers allowed in cross-origin requests. Defaults to all headers.
+        """
+        return pulumi.get(self, "allowed_headers")
+
+    @allowed_headers.setter
+    def allowed_headers(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "allowed_headers", value)
+
+    @property
+    @pulumi.getter(name="allowedMethods")
+    def allowed_methods(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The HTTP methods allowed in cross-origin requests. Defaults to ALL.
+        """
+        return pulumi.get(self, "allowed_methods")
+
+    @allowed_methods.setter
+    def allowed_methods(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "allowed_methods", value)
+
+    @property
+    @pulumi.getter(name="allowedOrigins")
+    def allowed_origins(self) -> Optional[Sequence[pulumi.Input[str]]]:
+        """
+        The origins allowed to access the files. Defaults to all origins.
+        """
+        return pulumi.get(self, "allowed_origins")
+
+    @allowed_origins.setter
+    def allowed_origins(self, value: Optional[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "allowed_origins", value)
+
+    @property
+    @pulumi.getter(name="exposeHeaders")
+    def expose_headers(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The headers exposed to cross-origin responses. Defaults to ETag, which multipart uploads need.
+        """
+        return pulumi.get(self, "expose_headers")
+
+    @expose_headers.setter
+    def expose_headers(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "expose_headers", value)
+
+    @property
+    @pulumi.getter(name="maxAgeSeconds")
+    def max_age_seconds(self) -> Optional[pulumi.Input[int]]:
+        """
+        The time browsers may cache preflight responses, in seconds. Defaults to 600.
+        """
+        return pulumi.get(self, "max_age_seconds")
+
+    @max_age_seconds.setter
+    def max_age_seconds(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "max_age_seconds", value)
+
+
+@pulumi.input_type
+class FileHostingEdgeHandlersArgs:
+    def __init__(__self__, *,
+                 origin_request_lambda_arn: Optional[pulumi.Input[str]] = None,
+                 origin_response_lambda_arn: Optional[pulumi.Input[str]] = None,
+                 viewer_request_function: Optional[pulumi.Input[str]] = None,
+                 viewer_request_lambda_arn: Optional[pulumi.Input[str]] = None,
+                 viewer_response_function: Optional[pulumi.Input[str]] = None,
+                 viewer_response_lambda_arn: Optional[pulumi.Input[str]] = None):
+        """
+        The edge handlers of a cache behavior of a FileHosting. Each event type takes either a CloudFront Function or a Lambda@Edge function.
+        :param pulumi.Input[str] origin_request_lambda_arn: The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin requests.
+        :param pulumi.Input[str] origin_response_lambda_arn: The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin responses, e.g. to set the Content-Disposition header before the response is cached.
+        :param pulumi.Input[str] viewer_request_function: The code of a CloudFront Function run on viewer requests, e.g. to rewrite paths or check tokens. The function is created and published with the cloudfront-js-2.0 runtime.
+        :param pulumi.Input[str] viewer_request_lambda_arn: The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer requests.
+        :param pulumi.Input[str] viewer_response_function: The code of a CloudFront Function run on viewer responses, e.g. to set headers.
+        :param pulumi.Input[str] viewer_response_lambda_arn: The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer responses.
+        """
+        if origin_request_lambda_arn is not None:
+            pulumi.set(__self__, "origin_request_lambda_arn", origin_request_lambda_arn)
+        if origin_response_lambda_arn is not None:
+            pulumi.set(__self__, "origin_response_lambda_arn", origin_response_lambda_arn)
+        if viewer_request_function is not None:
+            pulumi.set(__self__, "viewer_request_function", viewer_request_function)
+        if viewer_request_lambda_arn is not None:
+            pulumi.set(__self__, "viewer_request_lambda_arn", viewer_request_lambda_arn)
+        if viewer_response_function is not None:
+            pulumi.set(__self__, "viewer_response_function", viewer_response_function)
+        if viewer_response_lambda_arn is not None:
+            pulumi.set(__self__, "viewer_response_lambda_arn", viewer_response_lambda_arn)
+
+    @property
+    @pulumi.getter(name="originRequestLambdaArn")
+    def origin_request_lambda_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin requests.
+        """
+        return pulumi.get(self, "origin_request_lambda_arn")
+
+    @origin_request_lambda_arn.setter
+    def origin_request_lambda_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "origin_request_lambda_arn", value)
+
+    @property
+    @pulumi.getter(name="originResponseLambdaArn")
+    def origin_response_lambda_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The qualified ARN of a Lambda@Edge function version in us-east-1 run on origin responses, e.g. to set the Content-Disposition header before the response is cached.
+        """
+        return pulumi.get(self, "origin_response_lambda_arn")
+
+    @origin_response_lambda_arn.setter
+    def origin_response_lambda_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "origin_response_lambda_arn", value)
+
+    @property
+    @pulumi.getter(name="viewerRequestFunction")
+    def viewer_request_function(self) -> Optional[pulumi.Input[str]]:
+        """
+        The code of a CloudFront Function run on viewer requests, e.g. to rewrite paths or check tokens. The function is created and published with the cloudfront-js-2.0 runtime.
+        """
+        return pulumi.get(self, "viewer_request_function")
+
+    @viewer_request_function.setter
+    def viewer_request_function(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "viewer_request_function", value)
+
+    @property
+    @pulumi.getter(name="viewerRequestLambdaArn")
+    def viewer_request_lambda_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer requests.
+        """
+        return pulumi.get(self, "viewer_request_lambda_arn")
+
+    @viewer_request_lambda_arn.setter
+    def viewer_request_lambda_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "viewer_request_lambda_arn", value)
+
+    @property
+    @pulumi.getter(name="viewerResponseFunction")
+    def viewer_response_function(self) -> Optional[pulumi.Input[str]]:
+        """
+        The code of a CloudFront Function run on viewer responses, e.g. to set headers.
+        """
+        return pulumi.get(self, "viewer_response_function")
+
+    @viewer_response_function.setter
+    def viewer_response_function(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "viewer_response_function", value)
+
+    @property
+    @pulumi.getter(name="viewerResponseLambdaArn")
+    def viewer_response_lambda_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The qualified ARN of a Lambda@Edge function version in us-east-1 run on viewer responses.
+        """
+        return pulumi.get(self, "viewer_response_lambda_arn")
+
+    @viewer_response_lambda_arn.setter
+    def viewer_response_lambda_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "viewer_response_lambda_arn", value)
+
+
+@pulumi.input_type
+class FileHostingFailoverArgs:
+    def __init__(__self__, *,
+                 bucket_name: Optional[pulumi.Input[str]] = None,
+                 bucket_region: Optional[pulumi.Input[str]] = None,
+                 replication: Optional[bool] = None,
+                 status_codes: Optional[Sequence[pulumi.Input[int]]] = None):
+        """
+        The bucket a FileHosting fails over to.
+        :param pulumi.Input[str] bucket_name: The name of an existing bucket to fail over to. If not provided, a bucket is created.
+        :param pulumi.Input[str] bucket_region: The region of the failover bucket. Defaults to the region of the stack.
+        :param bool replication: Whether the objects of the bucket are replicated to the failover bucket. Both buckets need versioning enabled. Defaults to false.
+        :param Sequence[pulumi.Input[int]] status_codes: The status codes of the bucket on which CloudFront retries the request with the failover bucket. Defaults to 500, 502, 503, 504, 403 and 404.
+        """
+        if bucket_name is not None:
+            pulumi.set(__self__, "bucket_name", bucket_name)
+        if bucket_region is not None:
+            pulumi.set(__self__, "bucket_region", bucket_region)
+        if replication is not None:
+            pulumi.set(__self__, "replication", replication)
+        if status_codes is not None:
+            pulumi.set(__self__, "status_codes", status_codes)
+
+    @property
+    @pulumi.getter(name="bucketName")
+    def bucket_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of an existing bucket to fail over to. If not provided, a bucket is created.
+        """
+        return pulumi.get(self, "bucket_name")
+
+    @bucket_name.setter
+    def bucket_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "bucket_name", value)
+
+    @property
+    @pulumi.getter(name="bucketRegion")
+    def bucket_region(self) -> Optional[pulumi.Input[str]]:
+        """
+        The region of the failover bucket. Defaults to the region of the stack.
+        """
+        return pulumi.get(self, "bucket_region")
+
+    @bucket_region.setter
+    def bucket_region(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "bucket_region", value)
+
+    @property
+    @pulumi.getter
+    def replication(self) -> Optional[bool]:
+        """
+        Whether the objects of the bucket are replicated to the failover bucket. Both buckets need versioning enabled. Defaults to false.
+        """
+        return pulumi.get(self, "replication")
+
+    @replication.setter
+    def replication(self, value: Optional[bool]):
+        pulumi.set(self, "replication", value)
+
+    @property
+    @pulumi.getter(name="statusCodes")
+    def status_codes(self) -> Optional[Sequence[pulumi.Input[int]]]:
+        """
+        The status codes of the bucket on which CloudFront retries the request with the failover bucket. Defaults to 500, 502, 503, 504, 403 and 404.
+        """
+        return pulumi.get(self, "status_codes")
+
+    @status_codes.setter
+    def status_codes(self, value: Optional[Sequence[pulumi.Input[int]]]):
+        pulumi.set(self, "status_codes", value)
+
+
+@pulumi.input_type
+class FileHostingGeoRestrictionArgs:
+    def __init__(__self__, *,
+                 restriction_type: str,
+                 locations: Optional[Sequence[pulumi.Input[str]]] = None):
+        """
+        The geo restriction of a FileHosting.
+        :param str restriction_type: How the locations are applied: whitelist only allows requests from them, blacklist blocks them and none disables the restriction.
+        :param Sequence[pulumi.Input[str]] locations: The ISO 3166-1 alpha-2 codes of the countries the restriction type applies to.
+        """
+        pulumi.set(__self__, "restriction_type", restriction_type)
+        if locations is not None:
+            pulumi.set(__self__, "locations", locations)
+
+    @property
+    @pulumi.getter(name="restrictionType")
+    def restriction_type(self) -> str:
+        """
+        How the locations are applied: whitelist only allows requests from them, blacklist blocks them and none disables the restriction.
+        """
+        return pulumi.get(self, "restriction_type")
+
+    @restriction_type.setter
+    def restriction_type(self, value: str):
+        pulumi.set(self, "restriction_type", value)
+
+    @property
+    @pulumi.getter
+    def locations(self) -> Optional[Sequence[pulumi.Input[str]]]:
+        """
+        The ISO 3166-1 alpha-2 codes of the countries the restriction type applies to.
+        """
+        return pulumi.get(self, "locations")
+
+    @locations.setter
+    def locations(self, value: Optional[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "locations", value)
+
+
+@pulumi.input_type
+class FileHostingKeyRotationArgs:
+    def __init__(__self__, *,
+                 active_generation: Optional[int] = None,
+                 generations: Optional[int] = None):
+        """
+        The key rotation settings of a FileHosting.
+        :param int active_generation: The generation of the key used for signing. Incrementing it creates a new key and rotates signing to it. Defaults to 1.
+        :param int generations: The number of key generations kept in the trusted key group, including the active one. Previous keys stay trusted so that outstanding signatures remain valid. Defaults to 2.
+        """
+        if active_generation is not None:
+            pulumi.set(__self__, "active_generation", active_generation)
+        if generations is not None:
+            pulumi.set(__self__, "generations", generations)
+
+    @property
+    @pulumi.getter(name="activeGeneration")
+    def active_generation(self) -> Optional[int]:
+        """
+        The generation of the key used for signing. Incrementing it creates a new key and rotates signing to it. Defaults to 1.
+        """
+        return pulumi.get(self, "active_generation")
+
+    @active_generation.setter
+    def active_generation(self, value: Optional[int]):
+        pulumi.set(self, "active_generation", value)
+
+    @property
+    @pulumi.getter
+    def generations(self) -> Optional[int]:
+        """
+        The number of key generations kept in the trusted key group, including the active one. Previous keys stay trusted so that outstanding signatures remain valid. Defaults to 2.
+        """
+        return pulumi.get(self, "generations")
+
+    @generations.setter
+    def generations(self, value: Optional[int]):
+        pulumi.set(self, "generations", value)
+
+
+@pulumi.input_type
+class FileHostingLifecycleRuleArgs:
+    def __init__(__self__, *,
+                 expiration_days: Optional[pulumi.Input[int]] = None,
+                 noncurrent_version_expiration_days: Optional[pulumi.Input[int]] = None,
+                 prefix: Optional[pulumi.Input[str]] = None,
+                 transitions: Optional[Sequence[pulumi.Input['FileHostingLifecycleTransitionArgs']]] = None):
+        """
+        A lifecycle rule of the bucket of a FileHosting.
+        :param pulumi.Input[int] expiration_days: The number of days after which objects expire.
+        :param pulumi.Input[int] noncurrent_version_expiration_days: The number of days after which noncurrent object versions expire.
+        :param pulumi.Input[str] prefix: The key prefix the rule applies to. If not provided, the rule applies to all objects.
+        :param Sequence[pulumi.Input['FileHostingLifecycleTransitionArgs']] transitions: The transitions of objects to other storage classes.
+        """
+        if expiration_days is not None:
+            pulumi.set(__self__, "expiration_days", expiration_days)
+        if noncurrent_version_expiration_days is not None:
+            pulumi.set(__self__, "noncurrent_version_expiration_days", noncurrent_version_expiration_days)
+        if prefix is not None:
+            pulumi.set(__self__, "prefix", prefix)
+        if transitions is not None:
+            pulumi.set(__self__, "transitions", transitions)
+
+    @property
+    @pulumi.getter(name="expirationDays")
+    def expiration_days(self) -> Optional[pulumi.Input[int]]:
+        """
+        The number of days after which objects expire.
+        """
+        return pulumi.get(self, "expiration_days")
+
+    @expiration_days.setter
+    def expiration_days(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "expiration_days", value)
+
+    @property
+    @pulumi.getter(name="noncurrentVersionExpirationDays")
+    def noncurrent_version_expiration_days(self) -> Optional[pulumi.Input[int]]:
+        """
+        The number of days after which noncurrent object versions expire.
+        """
+        return pulumi.get(self, "noncurrent_version_expiration_days")
+
+    @noncurrent_version_expiration_days.setter
+    def noncurrent_version_expiration_days(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "noncurrent_version_expiration_days", value)
+
+    @property
+    @pulumi.getter
+    def prefix(self) -> Optional[pulumi.Input[str]]:
+        """
+        The key prefix the rule applies to. If not provided, the rule applies to all objects.
+        """
+        return pulumi.get(self, "prefix")
+
+    @prefix.setter
+    def prefix(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "prefix", value)
+
+    @property
+    @pulumi.getter
+    def transitions(self) -> Optional[Sequence[pulumi.Input['FileHostingLifecycleTransitionArgs']]]:
+        """
+        The transitions of objects to other storage classes.
+        """
+        return pulumi.get(self, "transitions")
+
+    @transitions.setter
+    def transitions(self, value: Optional[Sequence[pulumi.Input['FileHostingLifecycleTransitionArgs']]]):
+        pulumi.set(self, "transitions", value)
+
+
+@pulumi.input_type
+class FileHostingLifecycleTransitionArgs:
+    def __init__(__self__, *,
+                 days: pulumi.Input[int],
+                 storage_class: pulumi.Input[str]):
+        """
+        A transition of objects to another storage class.
+        :param pulumi.Input[int] days: The number of days after which objects are transitioned.
+        :param pulumi.Input[str] storage_class: The storage class objects are transitioned to, e.g. STANDARD_IA or GLACIER_IR.
+        """
+        pulumi.set(__self__, "days", days)
+        pulumi.set(__self__, "storage_class", storage_class)
+
+    @property
+    @pulumi.getter
+    def days(self) -> pulumi.Input[int]:
+        """
+        The number of days after which objects are transitioned.
+        """
+        return pulumi.get(self, "days")
+
+    @days.setter
+    def days(self, value: pulumi.Input[int]):
+        pulumi.set(self, "days", value)
+
+    @property
+    @pulumi.getter(name="storageClass")
+    def storage_class(self) -> pulumi.Input[str]:
+        """
+        The storage class objects are transitioned to, e.g. STANDARD_IA or GLACIER_IR.
+        """
+        return pulumi.get(self, "storage_class")
+
+    @storage_class.setter
+    def storage_class(self, value: pulumi.Input[str]):
+        pulumi.set(self, "storage_class", value)
+
+
+@pulumi.input_type
+class FileHostingLoggingArgs:
+    def __init__(__self__, *,
+                 bucket_name: Optional[pulumi.Input[str]] = None,
+                 cloud_front_prefix: Optional[pulumi.Input[str]] = None,
+                 include_cookies: Optional[pulumi.Input[bool]] = None,
+                 retention_days: Optional[pulumi.Input[int]] = None,
+                 s3_prefix: Optional[pulumi.Input[str]] = None):
+        """
+        The access logging settings of a FileHosting.
+        :param pulumi.Input[str] bucket_name: The name of an existing bucket to write the logs to. It needs ACLs enabled for CloudFront standard logs and a bucket policy allowing S3 server access logs. If not provided, a log bucket is created.
+        :param pulumi.Input[str] cloud_front_prefix: The key prefix of the CloudFront standard logs. Defaults to cloudfront/.
+        :param pulumi.Input[bool] include_cookies: Whether CloudFront logs include cookies. Defaults to false.
+        :param pulumi.Input[int] retention_days: The number of days logs are kept in a created log bucket. Defaults to 90.
+        :param pulumi.Input[str] s3_prefix: The key prefix of the S3 server access logs. Defaults to s3/.
+        """
+        if bucket_name is not None:
+            pulumi.set(__self__, "bucket_name", bucket_name)
+        if cloud_front_prefix is not None:
+            pulumi.set(__self__, "cloud_front_prefix", cloud_front_prefix)
+        if include_cookies is not None:
+            pulumi.set(__self__, "include_cookies", include_cookies)
+        if retention_days is not None:
+            pulumi.set(__self__, "retention_days", retention_days)
+        if s3_prefix is not None:
+            pulumi.set(__self__, "s3_prefix", s3_prefix)
+
+    @property
+    @pulumi.getter(name="bucketName")
+    def bucket_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of an existing bucket to write the logs to. It needs ACLs enabled for CloudFront standard logs and a bucket policy allowing S3 server access logs. If not provided, a log bucket is created.
+        """
+        return pulumi.get(self, "bucket_name")
+
+    @bucket_name.setter
+    def bucket_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "bucket_name", value)
+
+    @property
+    @pulumi.getter(name="cloudFrontPrefix")
+    def cloud_front_prefix(self) -> Optional[pulumi.Input[str]]:
+        """
+        The key prefix of the CloudFront standard logs. Defaults to cloudfront/.
+        """
+        return pulumi.get(self, "cloud_front_prefix")
+
+    @cloud_front_prefix.setter
+    def cloud_front_prefix(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "cloud_front_prefix", value)
+
+    @property
+    @pulumi.getter(name="includeCookies")
+    def include_cookies(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether CloudFront logs include cookies. Defaults to false.
+        """
+        return pulumi.get(self, "include_cookies")
+
+    @include_cookies.setter
+    def include_cookies(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "include_cookies", value)
+
+    @property
+    @pulumi.getter(name="retentionDays")
+    def retention_days(self) -> Optional[pulumi.Input[int]]:
+        """
+        The number of days logs are kept in a created log bucket. Defaults to 90.
+        """
+        return pulumi.get(self, "retention_days")
+
+    @retention_days.setter
+    def retention_days(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "retention_days", value)
+
+    @property
+    @pulumi.getter(name="s3Prefix")
+    def s3_prefix(self) -> Optional[pulumi.Input[str]]:
+        """
+        The key prefix of the S3 server access logs. Defaults to s3/.
+        """
+        return pulumi.get(self, "s3_prefix")
+
+    @s3_prefix.setter
+    def s3_prefix(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "s3_prefix", value)
+
+
+@pulumi.input_type
+class FileHostingNotificationsArgs:
+    def __init__(__self__, *,
+                 event_bridge: Optional[bool] = None,
+                 events: Optional[Sequence[pulumi.Input[str]]] = None,
+                 filter_prefix: Optional[pulumi.Input[str]] = None,
+                 filter_suffix: Optional[pulumi.Input[str]] = None,
+                 lambda_function_arn: Optional[pulumi.Input[str]] = None,
+                 queue: Optional[bool] = None,
+                 queue_arn: Optional[pulumi.Input[str]] = None,
+                 topic: Optional[bool] = None,
+                 topic_arn: Optional[pulumi.Input[str]] = None):
+        """
+        The event notifications of the bucket of a FileHosting. The notification configuration replaces any existing configuration of the bucket. The events go to a single destination and optionally to EventBridge.
+        :param bool event_bridge: Whether all events of the bucket are sent to EventBridge. Defaults to false.
+        :param Sequence[pulumi.Input[str]] events: The S3 events notifications are sent for. Defaults to s3:ObjectCreated:*.
+        :param pulumi.Input[str] filter_prefix: The key prefix of the objects notifications are sent for, e.g. uploads/.
+        :param pulumi.Input[str] filter_suffix: The key suffix of the objects notifications are sent for, e.g. .jpg.
+        :param pulumi.Input[str] lambda_function_arn: The ARN of a Lambda function in the region of the bucket to invoke. The permission for S3 to invoke it is created. Conflicts with the other destinations.
+        :param bool queue: Whether to create an SQS queue the notifications are sent to. Conflicts with the other destinations.
+        :param pulumi.Input[str] queue_arn: The ARN of an existing SQS queue to send the notifications to. Its policy has to allow S3 to send messages. Conflicts with the other destinations.
+        :param bool topic: Whether to create an SNS topic the notifications are published to. Conflicts with the other destinations.
+        :param pulumi.Input[str] topic_arn: The ARN of an existing SNS topic to publish the notifications to. Its policy has to allow S3 to publish. Conflicts with the other destinations.
+        """
+        if event_bridge is not None:
+            pulumi.set(__self__, "event_bridge", event_bridge)
+        if events is not None:
+            pulumi.set(__self__, "events", events)
+        if filter_prefix is not None:
+            pulumi.set(__self__, "filter_prefix", filter_prefix)
+        if filter_suffix is not None:
+            pulumi.set(__self__, "filter_suffix", filter_suffix)
+        if lambda_function_arn is not None:
+            pulumi.set(__self__, "lambda_function_arn", lambda_function_arn)
+        if queue is not None:
+            pulumi.set(__self__, "queue", queue)
+        if queue_arn is not None:
+            pulumi.set(__self__, "queue_arn", queue_arn)
+        if topic is not None:
+            pulumi.set(__self__, "topic", topic)
+        if topic_arn is not None:
+            pulumi.set(__self__, "topic_arn", topic_arn)
+
+    @property
+    @pulumi.getter(name="eventBridge")
+    def event_bridge(self) -> Optional[bool]:
+        """
+        Whether all events of the bucket are sent to EventBridge. Defaults to false.
+        """
+        return pulumi.get(self, "event_bridge")
+
+    @event_bridge.setter
+    def event_bridge(self, value: Optional[bool]):
+        pulumi.set(self, "event_bridge", value)
+
+    @property
+    @pulumi.getter
+    def events(self) -> Optional[Sequence[pulumi.Input[str]]]:
+        """
+        The S3 events notifications are sent for. Defaults to s3:ObjectCreated:*.
+        """
+        return pulumi.get(self, "events")
+
+    @events.setter
+    def events(self, value: Optional[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "events", value)
+
+    @property
+    @pulumi.getter(name="filterPrefix")
+    def filter_prefix(self) -> Optional[pulumi.Input[str]]:
+        """
+        The key prefix of the objects notifications are sent for, e.g. uploads/.
+        """
+        return pulumi.get(self, "filter_prefix")
+
+    @filter_prefix.setter
+    def filter_prefix(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "filter_prefix", value)
+
+    @property
+    @pulumi.getter(name="filterSuffix")
+    def filter_suffix(self) -> Optional[pulumi.Input[str]]:
+        """
+        The key suffix of the objects notifications are sent for, e.g. .jpg.
+        """
+        return pulumi.get(self, "filter_suffix")
+
+    @filter_suffix.setter
+    def filter_suffix(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "filter_suffix", value)
+
+    @property
+    @pulumi.getter(name="lambdaFunctionArn")
+    def lambda_function_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of a Lambda function in the region of the bucket to invoke. The permission for S3 to invoke it is created. Conflicts with the other destinations.
+        """
+        return pulumi.get(self, "lambda_function_arn")
+
+    @lambda_function_arn.setter
+    def lambda_function_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "lambda_function_arn", value)
+
+    @property
+    @pulumi.getter
+    def queue(self) -> Optional[bool]:
+        """
+        Whether to create an SQS queue the notifications are sent to. Conflicts with the other destinations.
+        """
+        return pulumi.get(self, "queue")
+
+    @queue.setter
+    def queue(self, value: Optional[bool]):
+        pulumi.set(self, "queue", value)
+
+    @property
+    @pulumi.getter(name="queueArn")
+    def queue_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of an existing SQS queue to send the notifications to. Its policy has to allow S3 to send messages. Conflicts with the other destinations.
+        """
+        return pulumi.get(self, "queue_arn")
+
+    @queue_arn.setter
+    def queue_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "queue_arn", value)
+
+    @property
+    @pulumi.getter
+    def topic(self) -> Optional[bool]:
+        """
+        Whether to create an SNS topic the notifications are published to. Conflicts with the other destinations.
+        """
+        return pulumi.get(self, "topic")
+
+    @topic.setter
+    def topic(self, value: Optional[bool]):
+        pulumi.set(self, "topic", value)
+
+    @property
+    @pulumi.getter(name="topicArn")
+    def topic_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of an existing SNS topic to publish the notifications to. Its policy has to allow S3 to publish. Conflicts with the other destinations.
+        """
+        return pulumi.get(self, "topic_arn")
+
+    @topic_arn.setter
+    def topic_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "topic_arn", value)
+
+
+@pulumi.input_type
+class FileHostingOriginRequestPolicyArgs:
+    def __init__(__self__, *,
+                 cookie_behavior: Optional[pulumi.Input[str]] = None,
+                 cookies: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 header_behavior: Optional[pulumi.Input[str]] = None,
+                 headers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 query_string_behavior: Optional[pulumi.Input[str]] = None,
+                 query_strings: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None):
+        """
+        The settings of the origin request policy a FileHosting creates.
+        :param pulumi.Input[str] cookie_behavior: Which cookies are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to none.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] cookies: The cookies the cookie behavior applies to.
+        :param pulumi.Input[str] header_behavior: Which headers are forwarded to the bucket: none, whitelist, allViewer, allViewerAndWhitelistCloudFront or allExcept. Defaults to whitelist.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] headers: The headers the header behavior applies to. Defaults to Content-Type.
+        :param pulumi.Input[str] query_string_behavior: Which query strings are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to whitelist.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] query_strings: The query strings the query string behavior applies to. Defaults to partNumber and uploadId, which multipart uploads need.
+        """
+        if cookie_behavior is not None:
+            pulumi.set(__self__, "cookie_behavior", cookie_behavior)
+        if cookies is not None:
+            pulumi.set(__self__, "cookies", cookies)
+        if header_behavior is not None:
+            pulumi.set(__self__, "header_behavior", header_behavior)
+        if headers is not None:
+            pulumi.set(__self__, "headers", headers)
+        if query_string_behavior is not None:
+            pulumi.set(__self__, "query_string_behavior", query_string_behavior)
+        if query_strings is not None:
+            pulumi.set(__self__, "query_strings", query_strings)
+
+    @property
+    @pulumi.getter(name="cookieBehavior")
+    def cookie_behavior(self) -> Optional[pulumi.Input[str]]:
+        """
+        Which cookies are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to none.
+        """
+        return pulumi.get(self, "cookie_behavior")
+
+    @cookie_behavior.setter
+    def cookie_behavior(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "cookie_behavior", value)
+
+    @property
+    @pulumi.getter
+    def cookies(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The cookies the cookie behavior applies to.
+        """
+        return pulumi.get(self, "cookies")
+
+    @cookies.setter
+    def cookies(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "cookies", value)
+
+    @property
+    @pulumi.getter(name="headerBehavior")
+    def header_behavior(self) -> Optional[pulumi.Input[str]]:
+        """
+        Which headers are forwarded to the bucket: none, whitelist, allViewer, allViewerAndWhitelistCloudFront or allExcept. Defaults to whitelist.
+        """
+        return pulumi.get(self, "header_behavior")
+
+    @header_behavior.setter
+    def header_behavior(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "header_behavior", value)
+
+    @property
+    @pulumi.getter
+    def headers(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The headers the header behavior applies to. Defaults to Content-Type.
+        """
+        return pulumi.get(self, "headers")
+
+    @headers.setter
+    def headers(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "headers", value)
+
+    @property
+    @pulumi.getter(name="queryStringBehavior")
+    def query_string_behavior(self) -> Optional[pulumi.Input[str]]:
+        """
+        Which query strings are forwarded to the bucket: none, whitelist, allExcept or all. Defaults to whitelist.
+        """
+        return pulumi.get(self, "query_string_behavior")
+
+    @query_string_behavior.setter
+    def query_string_behavior(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "query_string_behavior", value)
+
+    @property
+    @pulumi.getter(name="queryStrings")
+    def query_strings(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The query strings the query string behavior applies to. Defaults to partNumber and uploadId, which multipart uploads need.
+        """
+        return pulumi.get(self, "query_strings")
+
+    @query_strings.setter
+    def query_strings(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "query_strings", value)
+
+
+@pulumi.input_type
+class FileHostingSecurityHeadersArgs:
+    def __init__(__self__, *,
+                 content_security_policy: Optional[pulumi.Input[str]] = None,
+                 strict_transport_security_include_subdomains: Optional[pulumi.Input[bool]] = None,
+                 strict_transport_security_max_age: Optional[pulumi.Input[int]] = None,
+                 strict_transport_security_preload: Optional[pulumi.Input[bool]] = None):
+        """
+        The security headers of the response headers policy of a FileHosting.
+        :param pulumi.Input[str] content_security_policy: The Content-Security-Policy header. Defaults to default-src 'none', so hosted files cannot load or run anything when opened directly.
+        :param pulumi.Input[bool] strict_transport_security_include_subdomains: Whether the Strict-Transport-Security header includes subdomains. Defaults to true.
+        :param pulumi.Input[int] strict_transport_security_max_age: The max age of the Strict-Transport-Security header, in seconds. Defaults to 31536000.
+        :param pulumi.Input[bool] strict_transport_security_preload: Whether the Strict-Transport-Security header allows preloading. Defaults to false.
+        """
+        if content_security_policy is not None:
+            pulumi.set(__self__, "content_security_policy", content_security_policy)
+        if strict_transport_security_include_subdomains is not None:
+            pulumi.set(__self__, "strict_transport_security_include_subdomains", strict_transport_security_include_subdomains)
+        if strict_transport_security_max_age is not None:
+            pulumi.set(__self__, "strict_transport_security_max_age", strict_transport_security_max_age)
+        if strict_transport_security_preload is not None:
+            pulumi.set(__self__, "strict_transport_security_preload", strict_transport_security_preload)
+
+    @property
+    @pulumi.getter(name="contentSecurityPolicy")
+    def content_security_policy(self) -> Optional[pulumi.Input[str]]:
+        """
+        The Content-Security-Policy header. Defaults to default-src 'none', so hosted files cannot load or run anything when opened directly.
+        """
+        return pulumi.get(self, "content_security_policy")
+
+    @content_security_policy.setter
+    def content_security_policy(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "content_security_policy", value)
+
+    @property
+    @pulumi.getter(name="strictTransportSecurityIncludeSubdomains")
+    def strict_transport_security_include_subdomains(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the Strict-Transport-Security header includes subdomains. Defaults to true.
+        """
+        return pulumi.get(self, "strict_transport_security_include_subdomains")
+
+    @strict_transport_security_include_subdomains.setter
+    def strict_transport_security_include_subdomains(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "strict_transport_security_include_subdomains", value)
+
+    @property
+    @pulumi.getter(name="strictTransportSecurityMaxAge")
+    def strict_transport_security_max_age(self) -> Optional[pulumi.Input[int]]:
+        """
+        The max age of the Strict-Transport-Security header, in seconds. Defaults to 31536000.
+        """
+        return pulumi.get(self, "strict_transport_security_max_age")
+
+    @strict_transport_security_max_age.setter
+    def strict_transport_security_max_age(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "strict_transport_security_max_age", value)
+
+    @property
+    @pulumi.getter(name="strictTransportSecurityPreload")
+    def strict_transport_security_preload(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the Strict-Transport-Security header allows preloading. Defaults to false.
+        """
+        return pulumi.get(self, "strict_transport_security_preload")
+
+    @strict_transport_security_preload.setter
+    def strict_transport_security_preload(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "strict_transport_security_preload", value)
+
+
+@pulumi.input_type
+class WebAclArgs:
+    def __init__(__self__, *,
+                 allowed_ip_addresses: Optional[Sequence[pulumi.Input[str]]] = None,
+                 blocked_ip_addresses: Optional[Sequence[pulumi.Input[str]]] = None,
+                 managed_rule_groups: Optional[Sequence[pulumi.Input[str]]] = None,
+                 rate_limit: Optional[int] = None):
+        """
+        The settings of a WAF web ACL created for a CloudFront distribution.
+        :param Sequence[pulumi.Input[str]] allowed_ip_addresses: The IP addresses and CIDR ranges that are allowed. If provided, all other requests are blocked.
+        :param Sequence[pulumi.Input[str]] blocked_ip_addresses: The IP addresses and CIDR ranges that are blocked.
+        :param Sequence[pulumi.Input[str]] managed_rule_groups: The names of the AWS managed rule groups to apply. Defaults to AWSManagedRulesAmazonIpReputationList and AWSManagedRulesKnownBadInputsRuleSet.
+        :param int rate_limit: The maximum number of requests a single IP address may make in 5 minutes before it is blocked. Defaults to 2000, 0 disables rate limiting.
+        """
+        if allowed_ip_addresses is not None:
+            pulumi.set(__self__, "allowed_ip_addresses", allowed_ip_addresses)
+        if blocked_ip_addresses is not None:
+            pulumi.set(__self__, "blocked_ip_addresses", blocked_ip_addresses)
+        if managed_rule_groups is not None:
+            pulumi.set(__self__, "managed_rule_groups", managed_rule_groups)
+        if rate_limit is not None:
+            pulumi.set(__self__, "rate_limit", rate_limit)
+
+    @property
+    @pulumi.getter(name="allowedIpAddresses")
+    def allowed_ip_addresses(self) -> Optional[Sequence[pulumi.Input[str]]]:
+        """
+        The IP addresses and CIDR ranges that are allowed. If provided, all other requests are blocked.
+        """
+        return pulumi.get(self, "allowed_ip_addresses")
+
+    @allowed_ip_addresses.setter
+    def allowed_ip_addresses(self, value: Optional[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "allowed_ip_addresses", value)
+
+    @property
+    @pulumi.getter(name="blockedIpAddresses")
+    def blocked_ip_addresses(self) -> Optional[Sequence[pulumi.Input[str]]]:
+        """
+        The IP addresses and CIDR ranges that are blocked.
+        """
+        return pulumi.get(self, "blocked_ip_addresses")
+
+    @blocked_ip_addresses.setter
+    def blocked_ip_addresses(self, value: Optional[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "blocked_ip_addresses", value)
+
+    @property
+    @pulumi.getter(name="managedRuleGroups")
+    def managed_rule_groups(self) -> Optional[Sequence[pulumi.Input[str]]]:
+        """
+        The names of the AWS managed rule groups to apply. Defaults to AWSManagedRulesAmazonIpReputationList and AWSManagedRulesKnownBadInputsRuleSet.
+        """
+        return pulumi.get(self, "managed_rule_groups")
+
+    @managed_rule_groups.setter
+    def managed_rule_groups(self, value: Optional[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "managed_rule_groups", value)
+
+    @property
+    @pulumi.getter(name="rateLimit")
+    def rate_limit(self) -> Optional[int]:
+        """
+        The maximum number of requests a single IP address may make in 5 minutes before it is blocked. Defaults to 2000, 0 disables rate limiting.
+        """
+        return pulumi.get(self, "rate_limit")
+
+    @rate_limit.setter
+    def rate_limit(self, value: Optional[int]):
+        pulumi.set(self, "rate_limit", value)
+
+
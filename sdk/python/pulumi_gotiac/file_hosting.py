@@ -8,35 +8,189 @@ import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union, overload
 from . import _utilities
+from . import outputs
+from ._inputs import *
 
 __all__ = ['FileHostingArgs', 'FileHosting']
 
 @pulumi.input_type
 class FileHostingArgs:
     def __init__(__self__, *,
-                 domain: pulumi.Input[str],
-                 bucket_name: Optional[pulumi.Input[str]] = None):
+                 domain: str,
+                 aliases: Optional[Sequence[pulumi.Input[str]]] = None,
+                 bucket_kms_key_arn: Optional[pulumi.Input[str]] = None,
+                 bucket_name: Optional[pulumi.Input[str]] = None,
+                 bucket_options: Optional['FileHostingBucketArgs'] = None,
+                 bucket_policy_mode: Optional[str] = None,
+                 bucket_region: Optional[pulumi.Input[str]] = None,
+                 cache_behaviors: Optional[Sequence[pulumi.Input['FileHostingCacheBehaviorArgs']]] = None,
+                 cache_policy: Optional['FileHostingCachePolicyArgs'] = None,
+                 cache_policy_id: Optional[pulumi.Input[str]] = None,
+                 certificate_arn: Optional[pulumi.Input[str]] = None,
+                 certificate_validated: Optional[bool] = None,
+                 cors: Optional['FileHostingCorsArgs'] = None,
+                 edge_handlers: Optional['FileHostingEdgeHandlersArgs'] = None,
+                 failover: Optional['FileHostingFailoverArgs'] = None,
+                 geo_restriction: Optional['FileHostingGeoRestrictionArgs'] = None,
+                 hosted_zone_id: Optional[pulumi.Input[str]] = None,
+                 ipv6_enabled: Optional[bool] = None,
+                 key_rotation: Optional['FileHostingKeyRotationArgs'] = None,
+                 logging: Optional['FileHostingLoggingArgs'] = None,
+                 manage_dns: Optional[bool] = None,
+                 manage_ownership_controls: Optional[bool] = None,
+                 manage_public_access_block: Optional[bool] = None,
+                 managed_policies: Optional[bool] = None,
+                 notifications: Optional['FileHostingNotificationsArgs'] = None,
+                 origin_request_policy: Optional['FileHostingOriginRequestPolicyArgs'] = None,
+                 origin_request_policy_id: Optional[pulumi.Input[str]] = None,
+                 origin_shield_region: Optional[pulumi.Input[str]] = None,
+                 price_class: Optional[str] = None,
+                 security_headers: Optional['FileHostingSecurityHeadersArgs'] = None,
+                 upload_mode: Optional[str] = None,
+                 upload_path_pattern: Optional[str] = None,
+                 web_acl: Optional['WebAclArgs'] = None,
+                 web_acl_arn: Optional[pulumi.Input[str]] = None):
         """
         The set of arguments for constructing a FileHosting resource.
-        :param pulumi.Input[str] domain: The file hosting domain.
+        :param str domain: The file hosting domain.
+        :param Sequence[pulumi.Input[str]] aliases: Additional domains the files are served under. They are added to the certificate as subject alternative names.
+        :param pulumi.Input[str] bucket_kms_key_arn: The ARN of the KMS key the existing bucket is encrypted with, if it uses SSE-KMS. It is added to the bucket reader and writer policies. The key policy has to allow the distribution and the roles to use the key. Requires bucketName.
         :param pulumi.Input[str] bucket_name: The name of an existing s3 Bucket to link as origin. If not provided, a new bucket will be created.
+        :param 'FileHostingBucketArgs' bucket_options: The settings of the bucket that is created when no bucket name is given. Conflicts with bucketName.
+        :param str bucket_policy_mode: How the bucket policy is written: replace overwrites the whole policy, merge keeps the statements of other systems and only adds or updates the statements of the component. Merge requires bucketName. Defaults to replace.
+        :param pulumi.Input[str] bucket_region: The region of the bucket. The bucket and its policy are managed with a provider for this region. Defaults to the region of the stack.
+        :param Sequence[pulumi.Input['FileHostingCacheBehaviorArgs']] cache_behaviors: Additional cache behaviors for path patterns, in order of precedence. Paths that match none of the patterns use the default behavior, which requires signed URLs or cookies.
+        :param 'FileHostingCachePolicyArgs' cache_policy: The settings of the cache policy to create. Conflicts with cachePolicyId.
+        :param pulumi.Input[str] cache_policy_id: The ID of an existing cache policy to use instead of creating one.
+        :param pulumi.Input[str] certificate_arn: The ARN of an existing ACM certificate in us-east-1 covering the domain. If not provided, a new certificate will be issued and validated.
+        :param bool certificate_validated: Whether the validation records of a new certificate have been created in the external DNS provider. Without managed DNS and certificateArn, deploying takes two updates: the first only creates the certificate and returns its validation records, the second, with this set, waits for the validation and creates the distribution. Defaults to false.
+        :param 'FileHostingCorsArgs' cors: The CORS settings of the response headers policy. If not provided, all origins are allowed.
+        :param 'FileHostingEdgeHandlersArgs' edge_handlers: The CloudFront Functions and Lambda@Edge functions of the default cache behavior. They also run on the upload behavior of the signed-upload mode.
+        :param 'FileHostingFailoverArgs' failover: The bucket CloudFront fails over to when the bucket returns an error. If not provided, requests are only served from the bucket.
+        :param 'FileHostingGeoRestrictionArgs' geo_restriction: The countries requests are allowed from or blocked from. If not provided, requests from all countries are served.
+        :param pulumi.Input[str] hosted_zone_id: The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+        :param bool ipv6_enabled: Whether the distribution is reachable over IPv6. If true, AAAA records are created next to the A records. Defaults to true.
+        :param 'FileHostingKeyRotationArgs' key_rotation: The rotation of the keys trusted to sign URLs and cookies. If not provided, a single key is used.
+        :param 'FileHostingLoggingArgs' logging: The access logging settings. If not provided, no access logs are written.
+        :param bool manage_dns: Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Defaults to true.
+        :param bool manage_ownership_controls: Whether the ownership controls of the bucket are managed by the component. Defaults to true.
+        :param bool manage_public_access_block: Whether the public access block of the bucket is managed by the component. Defaults to true.
+        :param bool managed_policies: Whether managed IAM policies are created for the signer, bucket reader and bucket writer roles. The policy documents are returned either way. Defaults to false.
+        :param 'FileHostingNotificationsArgs' notifications: The event notifications of the bucket, e.g. to post-process uploaded files. If not provided, no notifications are configured.
+        :param 'FileHostingOriginRequestPolicyArgs' origin_request_policy: The settings of the origin request policy to create. Conflicts with originRequestPolicyId.
+        :param pulumi.Input[str] origin_request_policy_id: The ID of an existing origin request policy to use instead of creating one.
+        :param pulumi.Input[str] origin_shield_region: The region of the Origin Shield requests to the buckets go through. If not provided, Origin Shield is disabled.
+        :param str price_class: The price class of the distribution: PriceClass_All, PriceClass_200 or PriceClass_100. Defaults to PriceClass_All.
+        :param 'FileHostingSecurityHeadersArgs' security_headers: The security headers of the response headers policy.
+        :param str upload_mode: Which writes CloudFront passes to the bucket: full allows uploads through the default behavior, signed-upload only through the upload path pattern with its own key group and read-only none at all. Defaults to full.
+        :param str upload_path_pattern: The path pattern uploads are accepted under in signed-upload mode. Defaults to uploads/*.
+        :param 'WebAclArgs' web_acl: The settings of a WAF web ACL to create in us-east-1 and attach to the distribution. Conflicts with webAclArn.
+        :param pulumi.Input[str] web_acl_arn: The ARN of an existing WAF web ACL to attach to the distribution. Conflicts with webAcl.
         """
         pulumi.set(__self__, "domain", domain)
+        if aliases is not None:
+            pulumi.set(__self__, "aliases", aliases)
+        if bucket_kms_key_arn is not None:
+            pulumi.set(__self__, "bucket_kms_key_arn", bucket_kms_key_arn)
         if bucket_name is not None:
             pulumi.set(__self__, "bucket_name", bucket_name)
+        if bucket_options is not None:
+            pulumi.set(__self__, "bucket_options", bucket_options)
+        if bucket_policy_mode is not None:
+            pulumi.set(__self__, "bucket_policy_mode", bucket_policy_mode)
+        if bucket_region is not None:
+            pulumi.set(__self__, "bucket_region", bucket_region)
+        if cache_behaviors is not None:
+            pulumi.set(__self__, "cache_behaviors", cache_behaviors)
+        if cache_policy is not None:
+            pulumi.set(__self__, "cache_policy", cache_policy)
+        if cache_policy_id is not None:
+            pulumi.set(__self__, "cache_policy_id", cache_policy_id)
+        if certificate_arn is not None:
+            pulumi.set(__self__, "certificate_arn", certificate_arn)
+        if certificate_validated is not None:
+            pulumi.set(__self__, "certificate_validated", certificate_validated)
+        if cors is not None:
+            pulumi.set(__self__, "cors", cors)
+        if edge_handlers is not None:
+            pulumi.set(__self__, "edge_handlers", edge_handlers)
+        if failover is not None:
+            pulumi.set(__self__, "failover", failover)
+        if geo_restriction is not None:
+            pulumi.set(__self__, "geo_restriction", geo_restriction)
+        if hosted_zone_id is not None:
+            pulumi.set(__self__, "hosted_zone_id", hosted_zone_id)
+        if ipv6_enabled is not None:
+            pulumi.set(__self__, "ipv6_enabled", ipv6_enabled)
+        if key_rotation is not None:
+            pulumi.set(__self__, "key_rotation", key_rotation)
+        if logging is not None:
+            pulumi.set(__self__, "logging", logging)
+        if manage_dns is not None:
+            pulumi.set(__self__, "manage_dns", manage_dns)
+        if manage_ownership_controls is not None:
+            pulumi.set(__self__, "manage_ownership_controls", manage_ownership_controls)
+        if manage_public_access_block is not None:
+            pulumi.set(__self__, "manage_public_access_block", manage_public_access_block)
+        if managed_policies is not None:
+            pulumi.set(__self__, "managed_policies", managed_policies)
+        if notifications is not None:
+            pulumi.set(__self__, "notifications", notifications)
+        if origin_request_policy is not None:
+            pulumi.set(__self__, "origin_request_policy", origin_request_policy)
+        if origin_request_policy_id is not None:
+            pulumi.set(__self__, "origin_request_policy_id", origin_request_policy_id)
+        if origin_shield_region is not None:
+            pulumi.set(__self__, "origin_shield_region", origin_shield_region)
+        if price_class is not None:
+            pulumi.set(__self__, "price_class", price_class)
+        if security_headers is not None:
+            pulumi.set(__self__, "security_headers", security_headers)
+        if upload_mode is not None:
+            pulumi.set(__self__, "upload_mode", upload_mode)
+        if upload_path_pattern is not None:
+            pulumi.set(__self__, "upload_path_pattern", upload_path_pattern)
+        if web_acl is not None:
+            pulumi.set(__self__, "web_acl", web_acl)
+        if web_acl_arn is not None:
+            pulumi.set(__self__, "web_acl_arn", web_acl_arn)
 
     @property
     @pulumi.getter
-    def domain(self) -> pulumi.Input[str]:
+    def domain(self) -> str:
         """
         The file hosting domain.
         """
         return pulumi.get(self, "domain")
 
     @domain.setter
-    def domain(self, value: pulumi.Input[str]):
+    def domain(self, value: str):
         pulumi.set(self, "domain", value)
 
+    @property
+    @pulumi.getter
+    def aliases(self) -> Optional[Sequence[pulumi.Input[str]]]:
+        """
+        Additional domains the files are served under. They are added to the certificate as subject alternative names.
+        """
+        return pulumi.get(self, "aliases")
+
+    @aliases.setter
+    def aliases(self, value: Optional[Sequence[pulumi.Input[str]]]):
+        pulumi.set(self, "aliases", value)
+
+    @property
+    @pulumi.getter(name="bucketKmsKeyArn")
+    def bucket_kms_key_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of the KMS key the existing bucket is encrypted with, if it uses SSE-KMS. It is added to the bucket reader and writer policies. The key policy has to allow the distribution and the roles to use the key. Requires bucketName.
+        """
+        return pulumi.get(self, "bucket_kms_key_arn")
+
+    @bucket_kms_key_arn.setter
+    def bucket_kms_key_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "bucket_kms_key_arn", value)
+
     @property
     @pulumi.getter(name="bucketName")
     def bucket_name(self) -> Optional[pulumi.Input[str]]:
@@ -49,21 +203,445 @@ class FileHostingArgs:
     def bucket_name(self, value: Optional[pulumi.Input[str]]):
         pulumi.set(self, "bucket_name", value)
 
+    @property
+    @pulumi.getter(name="bucketOptions")
+    def bucket_options(self) -> Optional['FileHostingBucketArgs']:
+        """
+        The settings of the bucket that is created when no bucket name is given. Conflicts with bucketName.
+        """
+        return pulumi.get(self, "bucket_options")
+
+    @bucket_options.setter
+    def bucket_options(self, value: Optional['FileHostingBucketArgs']):
+        pulumi.set(self, "bucket_options", value)
+
+    @property
+    @pulumi.getter(name="bucketPolicyMode")
+    def bucket_policy_mode(self) -> Optional[str]:
+        """
+        How the bucket policy is written: replace overwrites the whole policy, merge keeps the statements of other systems and only adds or updates the statements of the component. Merge requires bucketName. Defaults to replace.
+        """
+        return pulumi.get(self, "bucket_policy_mode")
+
+    @bucket_policy_mode.setter
+    def bucket_policy_mode(self, value: Optional[str]):
+        pulumi.set(self, "bucket_policy_mode", value)
+
+    @property
+    @pulumi.getter(name="bucketRegion")
+    def bucket_region(self) -> Optional[pulumi.Input[str]]:
+        """
+        The region of the bucket. The bucket and its policy are managed with a provider for this region. Defaults to the region of the stack.
+        """
+        return pulumi.get(self, "bucket_region")
+
+    @bucket_region.setter
+    def bucket_region(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "bucket_region", value)
+
+    @property
+    @pulumi.getter(name="cacheBehaviors")
+    def cache_behaviors(self) -> Optional[Sequence[pulumi.Input['FileHostingCacheBehaviorArgs']]]:
+        """
+        Additional cache behaviors for path patterns, in order of precedence. Paths that match none of the patterns use the default behavior, which requires signed URLs or cookies.
+        """
+        return pulumi.get(self, "cache_behaviors")
+
+    @cache_behaviors.setter
+    def cache_behaviors(self, value: Optional[Sequence[pulumi.Input['FileHostingCacheBehaviorArgs']]]):
+        pulumi.set(self, "cache_behaviors", value)
+
+    @property
+    @pulumi.getter(name="cachePolicy")
+    def cache_policy(self) -> Optional['FileHostingCachePolicyArgs']:
+        """
+        The settings of the cache policy to create. Conflicts with cachePolicyId.
+        """
+        return pulumi.get(self, "cache_policy")
+
+    @cache_policy.setter
+    def cache_policy(self, value: Optional['FileHostingCachePolicyArgs']):
+        pulumi.set(self, "cache_policy", value)
+
+    @property
+    @pulumi.getter(name="cachePolicyId")
+    def cache_policy_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ID of an existing cache policy to use instead of creating one.
+        """
+        return pulumi.get(self, "cache_policy_id")
+
+    @cache_policy_id.setter
+    def cache_policy_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "cache_policy_id", value)
+
+    @property
+    @pulumi.getter(name="certificateArn")
+    def certificate_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of an existing ACM certificate in us-east-1 covering the domain. If not provided, a new certificate will be issued and validated.
+        """
+        return pulumi.get(self, "certificate_arn")
+
+    @certificate_arn.setter
+    def certificate_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "certificate_arn", value)
+
+    @property
+    @pulumi.getter(name="certificateValidated")
+    def certificate_validated(self) -> Optional[bool]:
+        """
+        Whether the validation records of a new certificate have been created in the external DNS provider. Without managed DNS and certificateArn, deploying takes two updates: the first only creates the certificate and returns its validation records, the second, with this set, waits for the validation and creates the distribution. Defaults to false.
+        """
+        return pulumi.get(self, "certificate_validated")
+
+    @certificate_validated.setter
+    def certificate_validated(self, value: Optional[bool]):
+        pulumi.set(self, "certificate_validated", value)
+
+    @property
+    @pulumi.getter
+    def cors(self) -> Optional['FileHostingCorsArgs']:
+        """
+        The CORS settings of the response headers policy. If not provided, all origins are allowed.
+        """
+        return pulumi.get(self, "cors")
+
+    @cors.setter
+    def cors(self, value: Optional['FileHostingCorsArgs']):
+        pulumi.set(self, "cors", value)
+
+    @property
+    @pulumi.getter(name="edgeHandlers")
+    def edge_handlers(self) -> Optional['FileHostingEdgeHandlersArgs']:
+        """
+        The CloudFront Functions and Lambda@Edge functions of the default cache behavior. They also run on the upload behavior of the signed-upload mode.
+        """
+        return pulumi.get(self, "edge_handlers")
+
+    @edge_handlers.setter
+    def edge_handlers(self, value: Optional['FileHostingEdgeHandlersArgs']):
+        pulumi.set(self, "edge_handlers", value)
+
+    @property
+    @pulumi.getter
+    def failover(self) -> Optional['FileHostingFailoverArgs']:
+        """
+        The bucket CloudFront fails over to when the bucket returns an error. If not provided, requests are only served from the bucket.
+        """
+        return pulumi.get(self, "failover")
+
+    @failover.setter
+    def failover(self, value: Optional['FileHostingFailoverArgs']):
+        pulumi.set(self, "failover", value)
+
+    @property
+    @pulumi.getter(name="geoRestriction")
+    def geo_restriction(self) -> Optional['FileHostingGeoRestrictionArgs']:
+        """
+        The countries requests are allowed from or blocked from. If not provided, requests from all countries are served.
+        """
+        return pulumi.get(self, "geo_restriction")
+
+    @geo_restriction.setter
+    def geo_restriction(self, value: Optional['FileHostingGeoRestrictionArgs']):
+        pulumi.set(self, "geo_restriction", value)
+
+    @property
+    @pulumi.getter(name="hostedZoneId")
+    def hosted_zone_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+        """
+        return pulumi.get(self, "hosted_zone_id")
+
+    @hosted_zone_id.setter
+    def hosted_zone_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "hosted_zone_id", value)
+
+    @property
+    @pulumi.getter(name="ipv6Enabled")
+    def ipv6_enabled(self) -> Optional[bool]:
+        """
+        Whether the distribution is reachable over IPv6. If true, AAAA records are created next to the A records. Defaults to true.
+        """
+        return pulumi.get(self, "ipv6_enabled")
+
+    @ipv6_enabled.setter
+    def ipv6_enabled(self, value: Optional[bool]):
+        pulumi.set(self, "ipv6_enabled", value)
+
+    @property
+    @pulumi.getter(name="keyRotation")
+    def key_rotation(self) -> Optional['FileHostingKeyRotationArgs']:
+        """
+        The rotation of the keys trusted to sign URLs and cookies. If not provided, a single key is used.
+        """
+        return pulumi.get(self, "key_rotation")
+
+    @key_rotation.setter
+    def key_rotation(self, value: Optional['FileHostingKeyRotationArgs']):
+        pulumi.set(self, "key_rotation", value)
+
+    @property
+    @pulumi.getter
+    def logging(self) -> Optional['FileHostingLoggingArgs']:
+        """
+        The access logging settings. If not provided, no access logs are written.
+        """
+        return pulumi.get(self, "logging")
+
+    @logging.setter
+    def logging(self, value: Optional['FileHostingLoggingArgs']):
+        pulumi.set(self, "logging", value)
+
+    @property
+    @pulumi.getter(name="manageDns")
+    def manage_dns(self) -> Optional[bool]:
+        """
+        Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Defaults to true.
+        """
+        return pulumi.get(self, "manage_dns")
+
+    @manage_dns.setter
+    def manage_dns(self, value: Optional[bool]):
+        pulumi.set(self, "manage_dns", value)
+
+    @property
+    @pulumi.getter(name="manageOwnershipControls")
+    def manage_ownership_controls(self) -> Optional[bool]:
+        """
+        Whether the ownership controls of the bucket are managed by the component. Defaults to true.
+        """
+        return pulumi.get(self, "manage_ownership_controls")
+
+    @manage_ownership_controls.setter
+    def manage_ownership_controls(self, value: Optional[bool]):
+        pulumi.set(self, "manage_ownership_controls", value)
+
+    @property
+    @pulumi.getter(name="managePublicAccessBlock")
+    def manage_public_access_block(self) -> Optional[bool]:
+        """
+        Whether the public access block of the bucket is managed by the component. Defaults to true.
+        """
+        return pulumi.get(self, "manage_public_access_block")
+
+    @manage_public_access_block.setter
+    def manage_public_access_block(self, value: Optional[bool]):
+        pulumi.set(self, "manage_public_access_block", value)
+
+    @property
+    @pulumi.getter(name="managedPolicies")
+    def managed_policies(self) -> Optional[bool]:
+        """
+        Whether managed IAM policies are created for the signer, bucket reader and bucket writer roles. The policy documents are returned either way. Defaults to false.
+        """
+        return pulumi.get(self, "managed_policies")
+
+    @managed_policies.setter
+    def managed_policies(self, value: Optional[bool]):
+        pulumi.set(self, "managed_policies", value)
+
+    @property
+    @pulumi.getter
+    def notifications(self) -> Optional['FileHostingNotificationsArgs']:
+        """
+        The event notifications of the bucket, e.g. to post-process uploaded files. If not provided, no notifications are configured.
+        """
+        return pulumi.get(self, "notifications")
+
+    @notifications.setter
+    def notifications(self, value: Optional['FileHostingNotificationsArgs']):
+        pulumi.set(self, "notifications", value)
+
+    @property
+    @pulumi.getter(name="originRequestPolicy")
+    def origin_request_policy(self) -> Optional['FileHostingOriginRequestPolicyArgs']:
+        """
+        The settings of the origin request policy to create. Conflicts with originRequestPolicyId.
+        """
+        return pulumi.get(self, "origin_request_policy")
+
+    @origin_request_policy.setter
+    def origin_request_policy(self, value: Optional['FileHostingOriginRequestPolicyArgs']):
+        pulumi.set(self, "origin_request_policy", value)
+
+    @property
+    @pulumi.getter(name="originRequestPolicyId")
+    def origin_request_policy_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ID of an existing origin request policy to use instead of creating one.
+        """
+        return pulumi.get(self, "origin_request_policy_id")
+
+    @origin_request_policy_id.setter
+    def origin_request_policy_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "origin_request_policy_id", value)
+
+    @property
+    @pulumi.getter(name="originShieldRegion")
+    def origin_shield_region(self) -> Optional[pulumi.Input[str]]:
+        """
+        The region of the Origin Shield requests to the buckets go through. If not provided, Origin Shield is disabled.
+        """
+        return pulumi.get(self, "origin_shield_region")
+
+    @origin_shield_region.setter
+    def origin_shield_region(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "origin_shield_region", value)
+
+    @property
+    @pulumi.getter(name="priceClass")
+    def price_class(self) -> Optional[str]:
+        """
+        The price class of the distribution: PriceClass_All, PriceClass_200 or PriceClass_100. Defaults to PriceClass_All.
+        """
+        return pulumi.get(self, "price_class")
+
+    @price_class.setter
+    def price_class(self, value: Optional[str]):
+        pulumi.set(self, "price_class", value)
+
+    @property
+    @pulumi.getter(name="securityHeaders")
+    def security_headers(self) -> Optional['FileHostingSecurityHeadersArgs']:
+        """
+        The security headers of the response headers policy.
+        """
+        return pulumi.get(self, "security_headers")
+
+    @security_headers.setter
+    def security_headers(self, value: Optional['FileHostingSecurityHeadersArgs']):
+        pulumi.set(self, "security_headers", value)
+
+    @property
+    @pulumi.getter(name="uploadMode")
+    def upload_mode(self) -> Optional[str]:
+        """
+        Which writes CloudFront passes to the bucket: full allows uploads through the default behavior, signed-upload only through the upload path pattern with its own key group and read-only none at all. Defaults to full.
+        """
+        return pulumi.get(self, "upload_mode")
+
+    @upload_mode.setter
+    def upload_mode(self, value: Optional[str]):
+        pulumi.set(self, "upload_mode", value)
+
+    @property
+    @pulumi.getter(name="uploadPathPattern")
+    def upload_path_pattern(self) -> Optional[str]:
+        """
+        The path pattern uploads are accepted under in signed-upload mode. Defaults to uploads/*.
+        """
+        return pulumi.get(self, "upload_path_pattern")
+
+    @upload_path_pattern.setter
+    def upload_path_pattern(self, value: Optional[str]):
+        pulumi.set(self, "upload_path_pattern", value)
+
+    @property
+    @pulumi.getter(name="webAcl")
+    def web_acl(self) -> Optional['WebAclArgs']:
+        """
+        The settings of a WAF web ACL to create in us-east-1 and attach to the distribution. Conflicts with webAclArn.
+        """
+        return pulumi.get(self, "web_acl")
+
+    @web_acl.setter
+    def web_acl(self, value: Optional['WebAclArgs']):
+        pulumi.set(self, "web_acl", value)
+
+    @property
+    @pulumi.getter(name="webAclArn")
+    def web_acl_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of an existing WAF web ACL to attach to the distribution. Conflicts with webAcl.
+        """
+        return pulumi.get(self, "web_acl_arn")
+
+    @web_acl_arn.setter
+    def web_acl_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "web_acl_arn", value)
+
 
 class FileHosting(pulumi.ComponentResource):
     @overload
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 aliases: Optional[Sequence[pulumi.Input[str]]] = None,
+                 bucket_kms_key_arn: Optional[pulumi.Input[str]] = None,
                  bucket_name: Optional[pulumi.Input[str]] = None,
-                 domain: Optional[pulumi.Input[str]] = None,
+                 bucket_options: Optional[pulumi.InputType['FileHostingBucketArgs']] = None,
+                 bucket_policy_mode: Optional[str] = None,
+                 bucket_region: Optional[pulumi.Input[str]] = None,
+                 cache_behaviors: Optional[Sequence[pulumi.Input[pulumi.InputType['FileHostingCacheBehaviorArgs']]]] = None,
+                 cache_policy: Optional[pulumi.InputType['FileHostingCachePolicyArgs']] = None,
+                 cache_policy_id: Optional[pulumi.Input[str]] = None,
+                 certificate_arn: Optional[pulumi.Input[str]] = None,
+                 certificate_validated: Optional[bool] = None,
+                 cors: Optional[pulumi.InputType['FileHostingCorsArgs']] = None,
+                 domain: Optional[str] = None,
+                 edge_handlers: Optional[pulumi.InputType['FileHostingEdgeHandlersArgs']] = None,
+                 failover: Optional[pulumi.InputType['FileHostingFailoverArgs']] = None,
+                 geo_restriction: Optional[pulumi.InputType['FileHostingGeoRestrictionArgs']] = None,
+                 hosted_zone_id: Optional[pulumi.Input[str]] = None,
+                 ipv6_enabled: Optional[bool] = None,
+                 key_rotation: Optional[pulumi.InputType['FileHostingKeyRotationArgs']] = None,
+                 logging: Optional[pulumi.InputType['FileHostingLoggingArgs']] = None,
+                 manage_dns: Optional[bool] = None,
+                 manage_ownership_controls: Optional[bool] = None,
+                 manage_public_access_block: Optional[bool] = None,
+                 managed_policies: Optional[bool] = None,
+                 notifications: Optional[pulumi.InputType['FileHostingNotificationsArgs']] = None,
+                 origin_request_policy: Optional[pulumi.InputType['FileHostingOriginRequestPolicyArgs']] = None,
+                 origin_request_policy_id: Optional[pulumi.Input[str]] = None,
+                 origin_shield_region: Optional[pulumi.Input[str]] = None,
+                 price_class: Optional[str] = None,
+                 security_headers: Optional[pulumi.InputType['FileHostingSecurityHeadersArgs']] = None,
+                 upload_mode: Optional[str] = None,
+                 upload_path_pattern: Optional[str] = None,
+                 web_acl: Optional[pulumi.InputType['WebAclArgs']] = None,
+                 web_acl_arn: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         """
         Create a FileHosting resource with the given unique name, props, and options.
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param Sequence[pulumi.Input[str]] aliases: Additional domains the files are served under. They are added to the certificate as subject alternative names.
+        :param pulumi.Input[str] bucket_kms_key_arn: The ARN of the KMS key the existing bucket is encrypted with, if it uses SSE-KMS. It is added to the bucket reader and writer policies. The key policy has to allow the distribution and the roles to use the key. Requires bucketName.
         :param pulumi.Input[str] bucket_name: The name of an existing s3 Bucket to link as origin. If not provided, a new bucket will be created.
-        :param pulumi.Input[str] domain: The file hosting domain.
+        :param pulumi.InputType['FileHostingBucketArgs'] bucket_options: The settings of the bucket that is created when no bucket name is given. Conflicts with bucketName.
+        :param str bucket_policy_mode: How the bucket policy is written: replace overwrites the whole policy, merge keeps the statements of other systems and only adds or updates the statements of the component. Merge requires bucketName. Defaults to replace.
+        :param pulumi.Input[str] bucket_region: The region of the bucket. The bucket and its policy are managed with a provider for this region. Defaults to the region of the stack.
+        :param Sequence[pulumi.Input[pulumi.InputType['FileHostingCacheBehaviorArgs']]] cache_behaviors: Additional cache behaviors for path patterns, in order of precedence. Paths that match none of the patterns use the default behavior, which requires signed URLs or cookies.
+        :param pulumi.InputType['FileHostingCachePolicyArgs'] cache_policy: The settings of the cache policy to create. Conflicts with cachePolicyId.
+        :param pulumi.Input[str] cache_policy_id: The ID of an existing cache policy to use instead of creating one.
+        :param pulumi.Input[str] certificate_arn: The ARN of an existing ACM certificate in us-east-1 covering the domain. If not provided, a new certificate will be issued and validated.
+        :param bool certificate_validated: Whether the validation records of a new certificate have been created in the external DNS provider. Without managed DNS and certificateArn, deploying takes two updates: the first only creates the certificate and returns its validation records, the second, with this set, waits for the validation and creates the distribution. Defaults to false.
+        :param pulumi.InputType['FileHostingCorsArgs'] cors: The CORS settings of the response headers policy. If not provided, all origins are allowed.
+        :param str domain: The file hosting domain.
+        :param pulumi.InputType['FileHostingEdgeHandlersArgs'] edge_handlers: The CloudFront Functions and Lambda@Edge functions of the default cache behavior. They also run on the upload behavior of the signed-upload mode.
+        :param pulumi.InputType['FileHostingFailoverArgs'] failover: The bucket CloudFront fails over to when the bucket returns an error. If not provided, requests are only served from the bucket.
+        :param pulumi.InputType['FileHostingGeoRestrictionArgs'] geo_restriction: The countries requests are allowed from or blocked from. If not provided, requests from all countries are served.
+        :param pulumi.Input[str] hosted_zone_id: The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+        :param bool ipv6_enabled: Whether the distribution is reachable over IPv6. If true, AAAA records are created next to the A records. Defaults to true.
+        :param pulumi.InputType['FileHostingKeyRotationArgs'] key_rotation: The rotation of the keys trusted to sign URLs and cookies. If not provided, a single key is used.
+        :param pulumi.InputType['FileHostingLoggingArgs'] logging: The access logging settings. If not provided, no access logs are written.
+        :param bool manage_dns: Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Defaults to true.
+        :param bool manage_ownership_controls: Whether the ownership controls of the bucket are managed by the component. Defaults to true.
+        :param bool manage_public_access_block: Whether the public access block of the bucket is managed by the component. Defaults to true.
+        :param bool managed_policies: Whether managed IAM policies are created for the signer, bucket reader and bucket writer roles. The policy documents are returned either way. Defaults to false.
+        :param pulumi.InputType['FileHostingNotificationsArgs'] notifications: The event notifications of the bucket, e.g. to post-process uploaded files. If not provided, no notifications are configured.
+        :param pulumi.InputType['FileHostingOriginRequestPolicyArgs'] origin_request_policy: The settings of the origin request policy to create. Conflicts with originRequestPolicyId.
+        :param pulumi.Input[str] origin_request_policy_id: The ID of an existing origin request policy to use instead of creating one.
+        :param pulumi.Input[str] origin_shield_region: The region of the Origin Shield requests to the buckets go through. If not provided, Origin Shield is disabled.
+        :param str price_class: The price class of the distribution: PriceClass_All, PriceClass_200 or PriceClass_100. Defaults to PriceClass_All.
+        :param pulumi.InputType['FileHostingSecurityHeadersArgs'] security_headers: The security headers of the response headers policy.
+        :param str upload_mode: Which writes CloudFront passes to the bucket: full allows uploads through the default behavior, signed-upload only through the upload path pattern with its own key group and read-only none at all. Defaults to full.
+        :param str upload_path_pattern: The path pattern uploads are accepted under in signed-upload mode. Defaults to uploads/*.
+        :param pulumi.InputType['WebAclArgs'] web_acl: The settings of a WAF web ACL to create in us-east-1 and attach to the distribution. Conflicts with webAclArn.
+        :param pulumi.Input[str] web_acl_arn: The ARN of an existing WAF web ACL to attach to the distribution. Conflicts with webAcl.
         """
         ...
     @overload
@@ -88,8 +666,40 @@ class FileHosting(pulumi.ComponentResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 aliases: Optional[Sequence[pulumi.Input[str]]] = None,
+                 bucket_kms_key_arn: Optional[pulumi.Input[str]] = None,
                  bucket_name: Optional[pulumi.Input[str]] = None,
-                 domain: Optional[pulumi.Input[str]] = None,
+                 bucket_options: Optional[pulumi.InputType['FileHostingBucketArgs']] = None,
+                 bucket_policy_mode: Optional[str] = None,
+                 bucket_region: Optional[pulumi.Input[str]] = None,
+                 cache_behaviors: Optional[Sequence[pulumi.Input[pulumi.InputType['FileHostingCacheBehaviorArgs']]]] = None,
+                 cache_policy: Optional[pulumi.InputType['FileHostingCachePolicyArgs']] = None,
+                 cache_policy_id: Optional[pulumi.Input[str]] = None,
+                 certificate_arn: Optional[pulumi.Input[str]] = None,
+                 certificate_validated: Optional[bool] = None,
+                 cors: Optional[pulumi.InputType['FileHostingCorsArgs']] = None,
+                 domain: Optional[str] = None,
+                 edge_handlers: Optional[pulumi.InputType['FileHostingEdgeHandlersArgs']] = None,
+                 failover: Optional[pulumi.InputType['FileHostingFailoverArgs']] = None,
+                 geo_restriction: Optional[pulumi.InputType['FileHostingGeoRestrictionArgs']] = None,
+                 hosted_zone_id: Optional[pulumi.Input[str]] = None,
+                 ipv6_enabled: Optional[bool] = None,
+                 key_rotation: Optional[pulumi.InputType['FileHostingKeyRotationArgs']] = None,
+                 logging: Optional[pulumi.InputType['FileHostingLoggingArgs']] = None,
+                 manage_dns: Optional[bool] = None,
+                 manage_ownership_controls: Optional[bool] = None,
+                 manage_public_access_block: Optional[bool] = None,
+                 managed_policies: Optional[bool] = None,
+                 notifications: Optional[pulumi.InputType['FileHostingNotificationsArgs']] = None,
+                 origin_request_policy: Optional[pulumi.InputType['FileHostingOriginRequestPolicyArgs']] = None,
+                 origin_request_policy_id: Optional[pulumi.Input[str]] = None,
+                 origin_shield_region: Optional[pulumi.Input[str]] = None,
+                 price_class: Optional[str] = None,
+                 security_headers: Optional[pulumi.InputType['FileHostingSecurityHeadersArgs']] = None,
+                 upload_mode: Optional[str] = None,
+                 upload_path_pattern: Optional[str] = None,
+                 web_acl: Optional[pulumi.InputType['WebAclArgs']] = None,
+                 web_acl_arn: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -101,13 +711,63 @@ class FileHosting(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = FileHostingArgs.__new__(FileHostingArgs)
 
+            __props__.__dict__["aliases"] = aliases
+            __props__.__dict__["bucket_kms_key_arn"] = bucket_kms_key_arn
             __props__.__dict__["bucket_name"] = bucket_name
+            __props__.__dict__["bucket_options"] = bucket_options
+            __props__.__dict__["bucket_policy_mode"] = bucket_policy_mode
+            __props__.__dict__["bucket_region"] = bucket_region
+            __props__.__dict__["cache_behaviors"] = cache_behaviors
+            __props__.__dict__["cache_policy"] = cache_policy
+            __props__.__dict__["cache_policy_id"] = cache_policy_id
+            __props__.__dict__["certificate_arn"] = certificate_arn
+            __props__.__dict__["certificate_validated"] = certificate_validated
+            __props__.__dict__["cors"] = cors
             if domain is None and not opts.urn:
                 raise TypeError("Missing required property 'domain'")
             __props__.__dict__["domain"] = domain
+            __props__.__dict__["edge_handlers"] = edge_handlers
+            __props__.__dict__["failover"] = failover
+            __props__.__dict__["geo_restriction"] = geo_restriction
+            __props__.__dict__["hosted_zone_id"] = hosted_zone_id
+            __props__.__dict__["ipv6_enabled"] = ipv6_enabled
+            __props__.__dict__["key_rotation"] = key_rotation
+            __props__.__dict__["logging"] = logging
+            __props__.__dict__["manage_dns"] = manage_dns
+            __props__.__dict__["manage_ownership_controls"] = manage_ownership_controls
+            __props__.__dict__["manage_public_access_block"] = manage_public_access_block
+            __props__.__dict__["managed_policies"] = managed_policies
+            __props__.__dict__["notifications"] = notifications
+            __props__.__dict__["origin_request_policy"] = origin_request_policy
+            __props__.__dict__["origin_request_policy_id"] = origin_request_policy_id
+            __props__.__dict__["origin_shield_region"] = origin_shield_region
+            __props__.__dict__["price_class"] = price_class
+            __props__.__dict__["security_headers"] = security_headers
+            __props__.__dict__["upload_mode"] = upload_mode
+            __props__.__dict__["upload_path_pattern"] = upload_path_pattern
+            __props__.__dict__["web_acl"] = web_acl
+            __props__.__dict__["web_acl_arn"] = web_acl_arn
+            __props__.__dict__["bucket_arn"] = None
+            __props__.__dict__["bucket_reader_policy"] = None
+            __props__.__dict__["bucket_reader_policy_arn"] = None
+            __props__.__dict__["bucket_writer_policy"] = None
+            __props__.__dict__["bucket_writer_policy_arn"] = None
+            __props__.__dict__["distribution_arn"] = None
+            __props__.__dict__["distribution_domain_name"] = None
+            __props__.__dict__["distribution_id"] = None
+            __props__.__dict__["key_group_id"] = None
+            __props__.__dict__["log_bucket_name"] = None
+            __props__.__dict__["notification_queue_arn"] = None
+            __props__.__dict__["notification_topic_arn"] = None
+            __props__.__dict__["origin_access_control_id"] = None
             __props__.__dict__["private_key_id"] = None
             __props__.__dict__["private_key_parameter_name"] = None
+            __props__.__dict__["signer_policy"] = None
+            __props__.__dict__["signer_policy_arn"] = None
+            __props__.__dict__["upload_private_key_id"] = None
+            __props__.__dict__["upload_private_key_parameter_name"] = None
             __props__.__dict__["url"] = None
+            __props__.__dict__["validation_records"] = None
         super(FileHosting, __self__).__init__(
             'gotiac:index:FileHosting',
             resource_name,
@@ -115,11 +775,131 @@ class FileHosting(pulumi.ComponentResource):
             opts,
             remote=True)
 
+    @property
+    @pulumi.getter(name="bucketArn")
+    def bucket_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the bucket the files are served from.
+        """
+        return pulumi.get(self, "bucket_arn")
+
+    @property
+    @pulumi.getter(name="bucketName")
+    def bucket_name(self) -> pulumi.Output[str]:
+        """
+        The name of the bucket the files are served from.
+        """
+        return pulumi.get(self, "bucket_name")
+
+    @property
+    @pulumi.getter(name="bucketReaderPolicy")
+    def bucket_reader_policy(self) -> pulumi.Output[str]:
+        """
+        The IAM policy document that allows listing and reading the files of the bucket, including decrypting them with the KMS key of the bucket if it is created with SSE-KMS or bucketKmsKeyArn is set.
+        """
+        return pulumi.get(self, "bucket_reader_policy")
+
+    @property
+    @pulumi.getter(name="bucketReaderPolicyArn")
+    def bucket_reader_policy_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the managed bucket reader policy. Empty unless managedPolicies is set.
+        """
+        return pulumi.get(self, "bucket_reader_policy_arn")
+
+    @property
+    @pulumi.getter(name="bucketWriterPolicy")
+    def bucket_writer_policy(self) -> pulumi.Output[str]:
+        """
+        The IAM policy document that allows listing, reading, writing and deleting the files of the bucket, including using the KMS key of the bucket if it is created with SSE-KMS or bucketKmsKeyArn is set.
+        """
+        return pulumi.get(self, "bucket_writer_policy")
+
+    @property
+    @pulumi.getter(name="bucketWriterPolicyArn")
+    def bucket_writer_policy_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the managed bucket writer policy. Empty unless managedPolicies is set.
+        """
+        return pulumi.get(self, "bucket_writer_policy_arn")
+
+    @property
+    @pulumi.getter(name="certificateArn")
+    def certificate_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the certificate of the distribution.
+        """
+        return pulumi.get(self, "certificate_arn")
+
+    @property
+    @pulumi.getter(name="distributionArn")
+    def distribution_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the CloudFront distribution.
+        """
+        return pulumi.get(self, "distribution_arn")
+
+    @property
+    @pulumi.getter(name="distributionDomainName")
+    def distribution_domain_name(self) -> pulumi.Output[str]:
+        """
+        The domain name of the CloudFront distribution.
+        """
+        return pulumi.get(self, "distribution_domain_name")
+
+    @property
+    @pulumi.getter(name="distributionId")
+    def distribution_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the CloudFront distribution.
+        """
+        return pulumi.get(self, "distribution_id")
+
+    @property
+    @pulumi.getter(name="keyGroupId")
+    def key_group_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the key group trusted to sign URLs and cookies.
+        """
+        return pulumi.get(self, "key_group_id")
+
+    @property
+    @pulumi.getter(name="logBucketName")
+    def log_bucket_name(self) -> pulumi.Output[str]:
+        """
+        The name of the bucket the access logs are written to. Empty unless logging is configured.
+        """
+        return pulumi.get(self, "log_bucket_name")
+
+    @property
+    @pulumi.getter(name="notificationQueueArn")
+    def notification_queue_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the queue the event notifications are sent to. Empty unless the component creates the queue.
+        """
+        return pulumi.get(self, "notification_queue_arn")
+
+    @property
+    @pulumi.getter(name="notificationTopicArn")
+    def notification_topic_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the topic the event notifications are published to. Empty unless the component creates the topic.
+        """
+        return pulumi.get(self, "notification_topic_arn")
+
+    @property
+    @pulumi.getter(name="originAccessControlId")
+    def origin_access_control_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the origin access control CloudFront reads the bucket with.
+        """
+        return pulumi.get(self, "origin_access_control_id")
+
     @property
     @pulumi.getter(name="privateKeyId")
     def private_key_id(self) -> pulumi.Output[str]:
         """
-        The ID the private key.
+        The ID of the public key of the active key generation.
         """
         return pulumi.get(self, "private_key_id")
 
@@ -127,10 +907,42 @@ class FileHosting(pulumi.ComponentResource):
     @pulumi.getter(name="privateKeyParameterName")
     def private_key_parameter_name(self) -> pulumi.Output[str]:
         """
-        The parameter name for the private key.
+        The parameter name for the private key of the active key generation.
         """
         return pulumi.get(self, "private_key_parameter_name")
 
+    @property
+    @pulumi.getter(name="signerPolicy")
+    def signer_policy(self) -> pulumi.Output[str]:
+        """
+        The IAM policy document that allows reading the private keys to sign URLs and cookies.
+        """
+        return pulumi.get(self, "signer_policy")
+
+    @property
+    @pulumi.getter(name="signerPolicyArn")
+    def signer_policy_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the managed signer policy. Empty unless managedPolicies is set.
+        """
+        return pulumi.get(self, "signer_policy_arn")
+
+    @property
+    @pulumi.getter(name="uploadPrivateKeyId")
+    def upload_private_key_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the public key of the active upload key generation. Empty unless the upload mode is signed-upload.
+        """
+        return pulumi.get(self, "upload_private_key_id")
+
+    @property
+    @pulumi.getter(name="uploadPrivateKeyParameterName")
+    def upload_private_key_parameter_name(self) -> pulumi.Output[str]:
+        """
+        The parameter name for the private key of the active upload key generation. Empty unless the upload mode is signed-upload.
+        """
+        return pulumi.get(self, "upload_private_key_parameter_name")
+
     @property
     @pulumi.getter
     def url(self) -> pulumi.Output[str]:
@@ -139,3 +951,119 @@ class FileHosting(pulumi.ComponentResource):
         """
         return pulumi.get(self, "url")
 
+    @property
+    @pulumi.getter(name="validationRecords")
+    def validation_records(self) -> pulumi.Output[Sequence['outputs.DnsRecord']]:
+        """
+        The certificate validation records to create when DNS is not managed by the component.
+        """
+        return pulumi.get(self, "validation_records")
+
+    @pulumi.output_type
+    class InvalidateResult:
+        def __init__(__self__, invalidation_id=None):
+            if invalidation_id and not isinstance(invalidation_id, str):
+                raise TypeError("Expected argument 'invalidation_id' to be a str")
+            pulumi.set(__self__, "invalidation_id", invalidation_id)
+
+        @property
+        @pulumi.getter(name="invalidationId")
+        def invalidation_id(self) -> str:
+            """
+            The ID of the invalidation. Empty in previews.
+            """
+            return pulumi.get(self, "invalidation_id")
+
+    def invalidate(__self__, *,
+                   paths: pulumi.Input[Sequence[pulumi.Input[str]]]) -> pulumi.Output['FileHosting.InvalidateResult']:
+        """
+        Invalidates paths of the file hosting distribution and waits until the invalidation has completed. Every update of the stack creates a new invalidation, previews create none. The invalidation is created with the credentials of the default AWS provider of the stack.
+
+
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] paths: The paths to invalidate, relative to the file hosting URL. Paths start with / and may end with a * wildcard.
+        """
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        __args__['paths'] = paths
+        return pulumi.runtime.call('gotiac:index:FileHosting/invalidate', __args__, res=__self__, typ=FileHosting.InvalidateResult)
+
+    @pulumi.output_type
+    class SignCookiesResult:
+        def __init__(__self__, cookies=None):
+            if cookies and not isinstance(cookies, dict):
+                raise TypeError("Expected argument 'cookies' to be a dict")
+            pulumi.set(__self__, "cookies", cookies)
+
+        @property
+        @pulumi.getter
+        def cookies(self) -> Mapping[str, str]:
+            """
+            The signed cookies by name.
+            """
+            return pulumi.get(self, "cookies")
+
+    def sign_cookies(__self__, *,
+                     expires_at: pulumi.Input[str],
+                     path: pulumi.Input[str],
+                     ip_address: Optional[pulumi.Input[str]] = None,
+                     starts_at: Optional[pulumi.Input[str]] = None,
+                     upload: Optional[bool] = None) -> pulumi.Output['FileHosting.SignCookiesResult']:
+        """
+        Creates the CloudFront signed cookies granting access to files of the file hosting.
+
+
+        :param pulumi.Input[str] expires_at: The time the signature expires at, in RFC 3339 format.
+        :param pulumi.Input[str] path: The path of the file, relative to the file hosting URL. It is the unescaped object key, which is escaped for the URL. May contain * wildcards.
+        :param pulumi.Input[str] ip_address: The IP address or CIDR range access is restricted to. Requires a custom policy.
+        :param pulumi.Input[str] starts_at: The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+        :param bool upload: Whether to sign with the upload key. Requires the signed-upload upload mode.
+        """
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        __args__['expiresAt'] = expires_at
+        __args__['path'] = path
+        __args__['ipAddress'] = ip_address
+        __args__['startsAt'] = starts_at
+        __args__['upload'] = upload
+        return pulumi.runtime.call('gotiac:index:FileHosting/signCookies', __args__, res=__self__, typ=FileHosting.SignCookiesResult)
+
+    @pulumi.output_type
+    class SignUrlResult:
+        def __init__(__self__, url=None):
+            if url and not isinstance(url, str):
+                raise TypeError("Expected argument 'url' to be a str")
+            pulumi.set(__self__, "url", url)
+
+        @property
+        @pulumi.getter
+        def url(self) -> str:
+            """
+            The signed URL.
+            """
+            return pulumi.get(self, "url")
+
+    def sign_url(__self__, *,
+                 expires_at: pulumi.Input[str],
+                 path: pulumi.Input[str],
+                 ip_address: Optional[pulumi.Input[str]] = None,
+                 starts_at: Optional[pulumi.Input[str]] = None,
+                 upload: Optional[bool] = None) -> pulumi.Output['FileHosting.SignUrlResult']:
+        """
+        Creates a CloudFront signed URL for a file of the file hosting.
+
+
+        :param pulumi.Input[str] expires_at: The time the signature expires at, in RFC 3339 format.
+        :param pulumi.Input[str] path: The path of the file, relative to the file hosting URL. It is the unescaped object key, which is escaped for the URL.
+        :param pulumi.Input[str] ip_address: The IP address or CIDR range access is restricted to. Requires a custom policy.
+        :param pulumi.Input[str] starts_at: The time the signature becomes valid at, in RFC 3339 format. Requires a custom policy.
+        :param bool upload: Whether to sign with the upload key. Requires the signed-upload upload mode.
+        """
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        __args__['expiresAt'] = expires_at
+        __args__['path'] = path
+        __args__['ipAddress'] = ip_address
+        __args__['startsAt'] = starts_at
+        __args__['upload'] = upload
+        return pulumi.runtime.call('gotiac:index:FileHosting/signUrl', __args__, res=__self__, typ=FileHosting.SignUrlResult)
+
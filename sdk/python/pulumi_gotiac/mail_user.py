@@ -0,0 +1,338 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+
+__all__ = ['MailUserArgs', 'MailUser']
+
+@pulumi.input_type
+class MailUserArgs:
+    def __init__(__self__, *,
+                 domain: pulumi.Input[str],
+                 email_prefix: pulumi.Input[str],
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 domain_identity_arn: Optional[pulumi.Input[str]] = None,
+                 enabled: Optional[pulumi.Input[bool]] = None,
+                 hosted_zone_id: Optional[pulumi.Input[str]] = None,
+                 manage_dns: Optional[bool] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 password_seed: Optional[str] = None,
+                 region: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a MailUser resource.
+        :param pulumi.Input[str] domain: The mail domain.
+        :param pulumi.Input[str] email_prefix: The local part of the email address.
+        :param pulumi.Input[str] display_name: The display name used in the sender address.
+        :param pulumi.Input[str] domain_identity_arn: The ARN of the SES identity of the domain, e.g. the domainIdentityArn output of another MailUser of the domain. If provided, the identity and its DNS records are not created, so that mailboxes of one domain do not compete for them.
+        :param pulumi.Input[bool] enabled: Whether the SMTP credentials are active. Defaults to true.
+        :param pulumi.Input[str] hosted_zone_id: The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+        :param bool manage_dns: Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Mail can be sent once SES has verified the domain through them. Defaults to true.
+        :param pulumi.Input[str] name: The name of the mailbox.
+        :param str password_seed: A seed that only triggers the rotation of the SMTP credentials, changing it replaces the access key. The credentials are generated by IAM, not derived from the seed, so it is not a secret.
+        :param pulumi.Input[str] region: The region of the SES identity and SMTP endpoint. Defaults to the region of the stack.
+        """
+        pulumi.set(__self__, "domain", domain)
+        pulumi.set(__self__, "email_prefix", email_prefix)
+        if display_name is not None:
+            pulumi.set(__self__, "display_name", display_name)
+        if domain_identity_arn is not None:
+            pulumi.set(__self__, "domain_identity_arn", domain_identity_arn)
+        if enabled is not None:
+            pulumi.set(__self__, "enabled", enabled)
+        if hosted_zone_id is not None:
+            pulumi.set(__self__, "hosted_zone_id", hosted_zone_id)
+        if manage_dns is not None:
+            pulumi.set(__self__, "manage_dns", manage_dns)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if password_seed is not None:
+            pulumi.set(__self__, "password_seed", password_seed)
+        if region is not None:
+            pulumi.set(__self__, "region", region)
+
+    @property
+    @pulumi.getter
+    def domain(self) -> pulumi.Input[str]:
+        """
+        The mail domain.
+        """
+        return pulumi.get(self, "domain")
+
+    @domain.setter
+    def domain(self, value: pulumi.Input[str]):
+        pulumi.set(self, "domain", value)
+
+    @property
+    @pulumi.getter(name="emailPrefix")
+    def email_prefix(self) -> pulumi.Input[str]:
+        """
+        The local part of the email address.
+        """
+        return pulumi.get(self, "email_prefix")
+
+    @email_prefix.setter
+    def email_prefix(self, value: pulumi.Input[str]):
+        pulumi.set(self, "email_prefix", value)
+
+    @property
+    @pulumi.getter(name="displayName")
+    def display_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The display name used in the sender address.
+        """
+        return pulumi.get(self, "display_name")
+
+    @display_name.setter
+    def display_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "display_name", value)
+
+    @property
+    @pulumi.getter(name="domainIdentityArn")
+    def domain_identity_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of the SES identity of the domain, e.g. the domainIdentityArn output of another MailUser of the domain. If provided, the identity and its DNS records are not created, so that mailboxes of one domain do not compete for them.
+        """
+        return pulumi.get(self, "domain_identity_arn")
+
+    @domain_identity_arn.setter
+    def domain_identity_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "domain_identity_arn", value)
+
+    @property
+    @pulumi.getter
+    def enabled(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the SMTP credentials are active. Defaults to true.
+        """
+        return pulumi.get(self, "enabled")
+
+    @enabled.setter
+    def enabled(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "enabled", value)
+
+    @property
+    @pulumi.getter(name="hostedZoneId")
+    def hosted_zone_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+        """
+        return pulumi.get(self, "hosted_zone_id")
+
+    @hosted_zone_id.setter
+    def hosted_zone_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "hosted_zone_id", value)
+
+    @property
+    @pulumi.getter(name="manageDns")
+    def manage_dns(self) -> Optional[bool]:
+        """
+        Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Mail can be sent once SES has verified the domain through them. Defaults to true.
+        """
+        return pulumi.get(self, "manage_dns")
+
+    @manage_dns.setter
+    def manage_dns(self, value: Optional[bool]):
+        pulumi.set(self, "manage_dns", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the mailbox.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="passwordSeed")
+    def password_seed(self) -> Optional[str]:
+        """
+        A seed that only triggers the rotation of the SMTP credentials, changing it replaces the access key. The credentials are generated by IAM, not derived from the seed, so it is not a secret.
+        """
+        return pulumi.get(self, "password_seed")
+
+    @password_seed.setter
+    def password_seed(self, value: Optional[str]):
+        pulumi.set(self, "password_seed", value)
+
+    @property
+    @pulumi.getter
+    def region(self) -> Optional[pulumi.Input[str]]:
+        """
+        The region of the SES identity and SMTP endpoint. Defaults to the region of the stack.
+        """
+        return pulumi.get(self, "region")
+
+    @region.setter
+    def region(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "region", value)
+
+
+class MailUser(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 domain: Optional[pulumi.Input[str]] = None,
+                 domain_identity_arn: Optional[pulumi.Input[str]] = None,
+                 email_prefix: Optional[pulumi.Input[str]] = None,
+                 enabled: Optional[pulumi.Input[bool]] = None,
+                 hosted_zone_id: Optional[pulumi.Input[str]] = None,
+                 manage_dns: Optional[bool] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 password_seed: Optional[str] = None,
+                 region: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Create a MailUser resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] display_name: The display name used in the sender address.
+        :param pulumi.Input[str] domain: The mail domain.
+        :param pulumi.Input[str] domain_identity_arn: The ARN of the SES identity of the domain, e.g. the domainIdentityArn output of another MailUser of the domain. If provided, the identity and its DNS records are not created, so that mailboxes of one domain do not compete for them.
+        :param pulumi.Input[str] email_prefix: The local part of the email address.
+        :param pulumi.Input[bool] enabled: Whether the SMTP credentials are active. Defaults to true.
+        :param pulumi.Input[str] hosted_zone_id: The ID of the hosted zone for the domain. If not provided, the hosted zone is looked up by the domain and its parent domains.
+        :param bool manage_dns: Whether to create the DNS records in Route 53. If false, no records are created and the records to create in an external DNS provider are returned as outputs. Mail can be sent once SES has verified the domain through them. Defaults to true.
+        :param pulumi.Input[str] name: The name of the mailbox.
+        :param str password_seed: A seed that only triggers the rotation of the SMTP credentials, changing it replaces the access key. The credentials are generated by IAM, not derived from the seed, so it is not a secret.
+        :param pulumi.Input[str] region: The region of the SES identity and SMTP endpoint. Defaults to the region of the stack.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: MailUserArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Create a MailUser resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param MailUserArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(MailUserArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 display_name: Optional[pulumi.Input[str]] = None,
+                 domain: Optional[pulumi.Input[str]] = None,
+                 domain_identity_arn: Optional[pulumi.Input[str]] = None,
+                 email_prefix: Optional[pulumi.Input[str]] = None,
+                 enabled: Optional[pulumi.Input[bool]] = None,
+                 hosted_zone_id: Optional[pulumi.Input[str]] = None,
+                 manage_dns: Optional[bool] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 password_seed: Optional[str] = None,
+                 region: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = MailUserArgs.__new__(MailUserArgs)
+
+            __props__.__dict__["display_name"] = display_name
+            if domain is None and not opts.urn:
+                raise TypeError("Missing required property 'domain'")
+            __props__.__dict__["domain"] = domain
+            __props__.__dict__["domain_identity_arn"] = domain_identity_arn
+            if email_prefix is None and not opts.urn:
+                raise TypeError("Missing required property 'email_prefix'")
+            __props__.__dict__["email_prefix"] = email_prefix
+            __props__.__dict__["enabled"] = enabled
+            __props__.__dict__["hosted_zone_id"] = hosted_zone_id
+            __props__.__dict__["manage_dns"] = manage_dns
+            __props__.__dict__["name"] = name
+            __props__.__dict__["password_seed"] = password_seed
+            __props__.__dict__["region"] = region
+            __props__.__dict__["dns_records"] = None
+            __props__.__dict__["email_address"] = None
+            __props__.__dict__["from_"] = None
+            __props__.__dict__["smtp_endpoint"] = None
+            __props__.__dict__["smtp_password_parameter_name"] = None
+            __props__.__dict__["smtp_username_parameter_name"] = None
+        super(MailUser, __self__).__init__(
+            'gotiac:index:MailUser',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter(name="dnsRecords")
+    def dns_records(self) -> pulumi.Output[Sequence['outputs.DnsRecord']]:
+        """
+        The SES verification and DKIM records to create when DNS is not managed by the component.
+        """
+        return pulumi.get(self, "dns_records")
+
+    @property
+    @pulumi.getter(name="domainIdentityArn")
+    def domain_identity_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the SES identity of the domain.
+        """
+        return pulumi.get(self, "domain_identity_arn")
+
+    @property
+    @pulumi.getter(name="emailAddress")
+    def email_address(self) -> pulumi.Output[str]:
+        """
+        The email address of the mailbox.
+        """
+        return pulumi.get(self, "email_address")
+
+    @property
+    @pulumi.getter(name="from")
+    def from_(self) -> pulumi.Output[str]:
+        """
+        The sender address including the display name.
+        """
+        return pulumi.get(self, "from_")
+
+    @property
+    @pulumi.getter(name="smtpEndpoint")
+    def smtp_endpoint(self) -> pulumi.Output[str]:
+        """
+        The SES SMTP endpoint.
+        """
+        return pulumi.get(self, "smtp_endpoint")
+
+    @property
+    @pulumi.getter(name="smtpPasswordParameterName")
+    def smtp_password_parameter_name(self) -> pulumi.Output[str]:
+        """
+        The parameter name for the SMTP password.
+        """
+        return pulumi.get(self, "smtp_password_parameter_name")
+
+    @property
+    @pulumi.getter(name="smtpUsernameParameterName")
+    def smtp_username_parameter_name(self) -> pulumi.Output[str]:
+        """
+        The parameter name for the SMTP username.
+        """
+        return pulumi.get(self, "smtp_username_parameter_name")
+
@@ -0,0 +1,188 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['S3GraphStoreArgs', 'S3GraphStore']
+
+@pulumi.input_type
+class S3GraphStoreArgs:
+    def __init__(__self__, *,
+                 bucket_name: Optional[pulumi.Input[str]] = None,
+                 force_destroy: Optional[pulumi.Input[bool]] = None,
+                 kms_key_arn: Optional[pulumi.Input[str]] = None,
+                 noncurrent_version_expiration_days: Optional[pulumi.Input[int]] = None):
+        """
+        The set of arguments for constructing a S3GraphStore resource.
+        :param pulumi.Input[str] bucket_name: The name of the bucket to create. If not provided, a unique name will be generated.
+        :param pulumi.Input[bool] force_destroy: Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+        :param pulumi.Input[str] kms_key_arn: The ARN of a KMS key used to encrypt the graph. If not provided, objects are encrypted with S3 managed keys.
+        :param pulumi.Input[int] noncurrent_version_expiration_days: The number of days noncurrent object versions are kept. Defaults to 30.
+        """
+        if bucket_name is not None:
+            pulumi.set(__self__, "bucket_name", bucket_name)
+        if force_destroy is not None:
+            pulumi.set(__self__, "force_destroy", force_destroy)
+        if kms_key_arn is not None:
+            pulumi.set(__self__, "kms_key_arn", kms_key_arn)
+        if noncurrent_version_expiration_days is not None:
+            pulumi.set(__self__, "noncurrent_version_expiration_days", noncurrent_version_expiration_days)
+
+    @property
+    @pulumi.getter(name="bucketName")
+    def bucket_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the bucket to create. If not provided, a unique name will be generated.
+        """
+        return pulumi.get(self, "bucket_name")
+
+    @bucket_name.setter
+    def bucket_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "bucket_name", value)
+
+    @property
+    @pulumi.getter(name="forceDestroy")
+    def force_destroy(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+        """
+        return pulumi.get(self, "force_destroy")
+
+    @force_destroy.setter
+    def force_destroy(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "force_destroy", value)
+
+    @property
+    @pulumi.getter(name="kmsKeyArn")
+    def kms_key_arn(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ARN of a KMS key used to encrypt the graph. If not provided, objects are encrypted with S3 managed keys.
+        """
+        return pulumi.get(self, "kms_key_arn")
+
+    @kms_key_arn.setter
+    def kms_key_arn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "kms_key_arn", value)
+
+    @property
+    @pulumi.getter(name="noncurrentVersionExpirationDays")
+    def noncurrent_version_expiration_days(self) -> Optional[pulumi.Input[int]]:
+        """
+        The number of days noncurrent object versions are kept. Defaults to 30.
+        """
+        return pulumi.get(self, "noncurrent_version_expiration_days")
+
+    @noncurrent_version_expiration_days.setter
+    def noncurrent_version_expiration_days(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "noncurrent_version_expiration_days", value)
+
+
+class S3GraphStore(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 bucket_name: Optional[pulumi.Input[str]] = None,
+                 force_destroy: Optional[pulumi.Input[bool]] = None,
+                 kms_key_arn: Optional[pulumi.Input[str]] = None,
+                 noncurrent_version_expiration_days: Optional[pulumi.Input[int]] = None,
+                 __props__=None):
+        """
+        Create a S3GraphStore resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] bucket_name: The name of the bucket to create. If not provided, a unique name will be generated.
+        :param pulumi.Input[bool] force_destroy: Whether the bucket can be destroyed while it still contains objects. Defaults to false.
+        :param pulumi.Input[str] kms_key_arn: The ARN of a KMS key used to encrypt the graph. If not provided, objects are encrypted with S3 managed keys.
+        :param pulumi.Input[int] noncurrent_version_expiration_days: The number of days noncurrent object versions are kept. Defaults to 30.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[S3GraphStoreArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Create a S3GraphStore resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param S3GraphStoreArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(S3GraphStoreArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 bucket_name: Optional[pulumi.Input[str]] = None,
+                 force_destroy: Optional[pulumi.Input[bool]] = None,
+                 kms_key_arn: Optional[pulumi.Input[str]] = None,
+                 noncurrent_version_expiration_days: Optional[pulumi.Input[int]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = S3GraphStoreArgs.__new__(S3GraphStoreArgs)
+
+            __props__.__dict__["bucket_name"] = bucket_name
+            __props__.__dict__["force_destroy"] = force_destroy
+            __props__.__dict__["kms_key_arn"] = kms_key_arn
+            __props__.__dict__["noncurrent_version_expiration_days"] = noncurrent_version_expiration_days
+            __props__.__dict__["bucket_arn"] = None
+            __props__.__dict__["reader_role_arn"] = None
+            __props__.__dict__["writer_role_arn"] = None
+        super(S3GraphStore, __self__).__init__(
+            'gotiac:index:S3GraphStore',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter(name="bucketArn")
+    def bucket_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the graph store bucket.
+        """
+        return pulumi.get(self, "bucket_arn")
+
+    @property
+    @pulumi.getter(name="bucketName")
+    def bucket_name(self) -> pulumi.Output[str]:
+        """
+        The name of the graph store bucket.
+        """
+        return pulumi.get(self, "bucket_name")
+
+    @property
+    @pulumi.getter(name="readerRoleArn")
+    def reader_role_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the role with read access to the nodes, edges and props prefixes.
+        """
+        return pulumi.get(self, "reader_role_arn")
+
+    @property
+    @pulumi.getter(name="writerRoleArn")
+    def writer_role_arn(self) -> pulumi.Output[str]:
+        """
+        The ARN of the role with read and write access to the nodes, edges and props prefixes.
+        """
+        return pulumi.get(self, "writer_role_arn")
+